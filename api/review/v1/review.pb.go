@@ -7,9 +7,11 @@
 package v1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -31,10 +33,13 @@ type ReviewRecord struct {
 	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
 	Rating        int32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
 	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // unix seconds
-	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                         // PENDING|APPROVED|REJECTED
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                         // PENDING|APPROVED|REJECTED|APPEALED
 	AuditReason   string                 `protobuf:"bytes,8,opt,name=audit_reason,json=auditReason,proto3" json:"audit_reason,omitempty"`
 	AuditBy       uint64                 `protobuf:"varint,9,opt,name=audit_by,json=auditBy,proto3" json:"audit_by,omitempty"`
 	AuditAt       int64                  `protobuf:"varint,10,opt,name=audit_at,json=auditAt,proto3" json:"audit_at,omitempty"`
+	ReplyCount    int32                  `protobuf:"varint,11,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`      // merchant replies
+	LastReplyAt   int64                  `protobuf:"varint,12,opt,name=last_reply_at,json=lastReplyAt,proto3" json:"last_reply_at,omitempty"` // unix seconds, 0 without replies
+	Version       uint64                 `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`                              // 每次修改加一；HTTP 详情接口同时以 ETag 返回
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -139,9 +144,30 @@ func (x *ReviewRecord) GetAuditAt() int64 {
 	return 0
 }
 
+func (x *ReviewRecord) GetReplyCount() int32 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *ReviewRecord) GetLastReplyAt() int64 {
+	if x != nil {
+		return x.LastReplyAt
+	}
+	return 0
+}
+
+func (x *ReviewRecord) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type CreateReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 启用认证时取自 token，忽略请求中的值；未启用认证时必填
 	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
 	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 	Rating        int32                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
@@ -252,13 +278,20 @@ func (x *CreateReviewReply) GetId() uint64 {
 }
 
 type UpdateReviewRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	Rating        int32                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subject string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// 被更新时不能为空
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// 被更新时须为 1-5
+	Rating int32 `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	// 要更新的字段：subject|content|rating，不传则更新全部三个字段
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// 期望的当前版本号（ReviewRecord.version），不一致时返回 409；0 表示不校验。
+	// HTTP 也可通过 If-Match 头传递
+	ExpectedVersion uint64 `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateReviewRequest) Reset() {
@@ -319,6 +352,20 @@ func (x *UpdateReviewRequest) GetRating() int32 {
 	return 0
 }
 
+func (x *UpdateReviewRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateReviewRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateReviewReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -435,6 +482,86 @@ func (*DeleteReviewReply) Descriptor() ([]byte, []int) {
 	return file_review_v1_review_proto_rawDescGZIP(), []int{6}
 }
 
+type RestoreReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreReviewRequest) Reset() {
+	*x = RestoreReviewRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreReviewRequest) ProtoMessage() {}
+
+func (x *RestoreReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreReviewRequest.ProtoReflect.Descriptor instead.
+func (*RestoreReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RestoreReviewRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreReviewReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreReviewReply) Reset() {
+	*x = RestoreReviewReply{}
+	mi := &file_review_v1_review_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreReviewReply) ProtoMessage() {}
+
+func (x *RestoreReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreReviewReply.ProtoReflect.Descriptor instead.
+func (*RestoreReviewReply) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{8}
+}
+
 type GetReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -444,7 +571,7 @@ type GetReviewRequest struct {
 
 func (x *GetReviewRequest) Reset() {
 	*x = GetReviewRequest{}
-	mi := &file_review_v1_review_proto_msgTypes[7]
+	mi := &file_review_v1_review_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -456,7 +583,7 @@ func (x *GetReviewRequest) String() string {
 func (*GetReviewRequest) ProtoMessage() {}
 
 func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_review_v1_review_proto_msgTypes[7]
+	mi := &file_review_v1_review_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,7 +596,7 @@ func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReviewRequest.ProtoReflect.Descriptor instead.
 func (*GetReviewRequest) Descriptor() ([]byte, []int) {
-	return file_review_v1_review_proto_rawDescGZIP(), []int{7}
+	return file_review_v1_review_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetReviewRequest) GetId() uint64 {
@@ -488,7 +615,7 @@ type GetReviewReply struct {
 
 func (x *GetReviewReply) Reset() {
 	*x = GetReviewReply{}
-	mi := &file_review_v1_review_proto_msgTypes[8]
+	mi := &file_review_v1_review_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -500,7 +627,7 @@ func (x *GetReviewReply) String() string {
 func (*GetReviewReply) ProtoMessage() {}
 
 func (x *GetReviewReply) ProtoReflect() protoreflect.Message {
-	mi := &file_review_v1_review_proto_msgTypes[8]
+	mi := &file_review_v1_review_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -513,7 +640,7 @@ func (x *GetReviewReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReviewReply.ProtoReflect.Descriptor instead.
 func (*GetReviewReply) Descriptor() ([]byte, []int) {
-	return file_review_v1_review_proto_rawDescGZIP(), []int{8}
+	return file_review_v1_review_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetReviewReply) GetReview() *ReviewRecord {
@@ -537,14 +664,19 @@ type ListReviewRequest struct {
 	// 排序字段："relevance"|"ts"|"rating"（默认：relevance）
 	Sort string `protobuf:"bytes,7,opt,name=sort,proto3" json:"sort,omitempty"`
 	// 排序方向："asc"|"desc"（默认：desc）
-	Order         string `protobuf:"bytes,8,opt,name=order,proto3" json:"order,omitempty"`
+	Order string `protobuf:"bytes,8,opt,name=order,proto3" json:"order,omitempty"`
+	// 过滤：商家是否已回复（不传则不过滤）
+	HasReply *bool `protobuf:"varint,9,opt,name=has_reply,json=hasReply,proto3,oneof" json:"has_reply,omitempty"`
+	// 过滤：状态 PENDING|APPROVED|REJECTED|APPEALED。非运营调用方只能看到 APPROVED
+	// 及自己的 PENDING 评价，该过滤只会在此范围内进一步收窄
+	Statuses      []string `protobuf:"bytes,10,rep,name=statuses,proto3" json:"statuses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListReviewRequest) Reset() {
 	*x = ListReviewRequest{}
-	mi := &file_review_v1_review_proto_msgTypes[9]
+	mi := &file_review_v1_review_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -556,7 +688,7 @@ func (x *ListReviewRequest) String() string {
 func (*ListReviewRequest) ProtoMessage() {}
 
 func (x *ListReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_review_v1_review_proto_msgTypes[9]
+	mi := &file_review_v1_review_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,7 +701,7 @@ func (x *ListReviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReviewRequest.ProtoReflect.Descriptor instead.
 func (*ListReviewRequest) Descriptor() ([]byte, []int) {
-	return file_review_v1_review_proto_rawDescGZIP(), []int{9}
+	return file_review_v1_review_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListReviewRequest) GetPage() int32 {
@@ -628,6 +760,20 @@ func (x *ListReviewRequest) GetOrder() string {
 	return ""
 }
 
+func (x *ListReviewRequest) GetHasReply() bool {
+	if x != nil && x.HasReply != nil {
+		return *x.HasReply
+	}
+	return false
+}
+
+func (x *ListReviewRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
 type ListReviewReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
@@ -638,7 +784,7 @@ type ListReviewReply struct {
 
 func (x *ListReviewReply) Reset() {
 	*x = ListReviewReply{}
-	mi := &file_review_v1_review_proto_msgTypes[10]
+	mi := &file_review_v1_review_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -650,7 +796,7 @@ func (x *ListReviewReply) String() string {
 func (*ListReviewReply) ProtoMessage() {}
 
 func (x *ListReviewReply) ProtoReflect() protoreflect.Message {
-	mi := &file_review_v1_review_proto_msgTypes[10]
+	mi := &file_review_v1_review_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -663,7 +809,7 @@ func (x *ListReviewReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReviewReply.ProtoReflect.Descriptor instead.
 func (*ListReviewReply) Descriptor() ([]byte, []int) {
-	return file_review_v1_review_proto_rawDescGZIP(), []int{10}
+	return file_review_v1_review_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListReviewReply) GetTotal() int64 {
@@ -681,18 +827,20 @@ func (x *ListReviewReply) GetReviews() []*ReviewRecord {
 }
 
 type AuditReviewRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Decision      string                 `protobuf:"bytes,2,opt,name=decision,proto3" json:"decision,omitempty"` // APPROVE|REJECT
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	OperatorId    uint64                 `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Decision   string                 `protobuf:"bytes,2,opt,name=decision,proto3" json:"decision,omitempty"`
+	Reason     string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	OperatorId uint64                 `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 启用认证时取自 token，忽略请求中的值；未启用认证时必填
+	// 期望的当前版本号，不一致时返回 409；0 表示不校验。HTTP 也可通过 If-Match 头传递
+	ExpectedVersion uint64 `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *AuditReviewRequest) Reset() {
 	*x = AuditReviewRequest{}
-	mi := &file_review_v1_review_proto_msgTypes[11]
+	mi := &file_review_v1_review_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -704,7 +852,7 @@ func (x *AuditReviewRequest) String() string {
 func (*AuditReviewRequest) ProtoMessage() {}
 
 func (x *AuditReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_review_v1_review_proto_msgTypes[11]
+	mi := &file_review_v1_review_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,7 +865,7 @@ func (x *AuditReviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditReviewRequest.ProtoReflect.Descriptor instead.
 func (*AuditReviewRequest) Descriptor() ([]byte, []int) {
-	return file_review_v1_review_proto_rawDescGZIP(), []int{11}
+	return file_review_v1_review_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AuditReviewRequest) GetId() uint64 {
@@ -748,6 +896,13 @@ func (x *AuditReviewRequest) GetOperatorId() uint64 {
 	return 0
 }
 
+func (x *AuditReviewRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type AuditReviewReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -756,7 +911,7 @@ type AuditReviewReply struct {
 
 func (x *AuditReviewReply) Reset() {
 	*x = AuditReviewReply{}
-	mi := &file_review_v1_review_proto_msgTypes[12]
+	mi := &file_review_v1_review_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -768,7 +923,7 @@ func (x *AuditReviewReply) String() string {
 func (*AuditReviewReply) ProtoMessage() {}
 
 func (x *AuditReviewReply) ProtoReflect() protoreflect.Message {
-	mi := &file_review_v1_review_proto_msgTypes[12]
+	mi := &file_review_v1_review_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -781,13 +936,109 @@ func (x *AuditReviewReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditReviewReply.ProtoReflect.Descriptor instead.
 func (*AuditReviewReply) Descriptor() ([]byte, []int) {
-	return file_review_v1_review_proto_rawDescGZIP(), []int{12}
+	return file_review_v1_review_proto_rawDescGZIP(), []int{14}
+}
+
+type AppealReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 启用认证时取自 token，忽略请求中的值；未启用认证时必填
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppealReviewRequest) Reset() {
+	*x = AppealReviewRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealReviewRequest) ProtoMessage() {}
+
+func (x *AppealReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealReviewRequest.ProtoReflect.Descriptor instead.
+func (*AppealReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AppealReviewRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AppealReviewRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AppealReviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AppealReviewReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppealReviewReply) Reset() {
+	*x = AppealReviewReply{}
+	mi := &file_review_v1_review_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealReviewReply) ProtoMessage() {}
+
+func (x *AppealReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealReviewReply.ProtoReflect.Descriptor instead.
+func (*AppealReviewReply) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{16}
 }
 
 type CreateReplyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // review id
-	MerchantId    uint64                 `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                   // review id
+	MerchantId    uint64                 `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"` // 启用认证时取自 token，忽略请求中的值；未启用认证时必填
 	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -795,7 +1046,7 @@ type CreateReplyRequest struct {
 
 func (x *CreateReplyRequest) Reset() {
 	*x = CreateReplyRequest{}
-	mi := &file_review_v1_review_proto_msgTypes[13]
+	mi := &file_review_v1_review_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -807,7 +1058,7 @@ func (x *CreateReplyRequest) String() string {
 func (*CreateReplyRequest) ProtoMessage() {}
 
 func (x *CreateReplyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_review_v1_review_proto_msgTypes[13]
+	mi := &file_review_v1_review_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -820,7 +1071,7 @@ func (x *CreateReplyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReplyRequest.ProtoReflect.Descriptor instead.
 func (*CreateReplyRequest) Descriptor() ([]byte, []int) {
-	return file_review_v1_review_proto_rawDescGZIP(), []int{13}
+	return file_review_v1_review_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CreateReplyRequest) GetId() uint64 {
@@ -852,7 +1103,7 @@ type CreateReplyReply struct {
 
 func (x *CreateReplyReply) Reset() {
 	*x = CreateReplyReply{}
-	mi := &file_review_v1_review_proto_msgTypes[14]
+	mi := &file_review_v1_review_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -864,7 +1115,7 @@ func (x *CreateReplyReply) String() string {
 func (*CreateReplyReply) ProtoMessage() {}
 
 func (x *CreateReplyReply) ProtoReflect() protoreflect.Message {
-	mi := &file_review_v1_review_proto_msgTypes[14]
+	mi := &file_review_v1_review_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -877,7 +1128,7 @@ func (x *CreateReplyReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReplyReply.ProtoReflect.Descriptor instead.
 func (*CreateReplyReply) Descriptor() ([]byte, []int) {
-	return file_review_v1_review_proto_rawDescGZIP(), []int{14}
+	return file_review_v1_review_proto_rawDescGZIP(), []int{18}
 }
 
 type ListRepliesRequest struct {
@@ -889,7 +1140,7 @@ type ListRepliesRequest struct {
 
 func (x *ListRepliesRequest) Reset() {
 	*x = ListRepliesRequest{}
-	mi := &file_review_v1_review_proto_msgTypes[15]
+	mi := &file_review_v1_review_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -901,7 +1152,7 @@ func (x *ListRepliesRequest) String() string {
 func (*ListRepliesRequest) ProtoMessage() {}
 
 func (x *ListRepliesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_review_v1_review_proto_msgTypes[15]
+	mi := &file_review_v1_review_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -914,7 +1165,7 @@ func (x *ListRepliesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRepliesRequest.ProtoReflect.Descriptor instead.
 func (*ListRepliesRequest) Descriptor() ([]byte, []int) {
-	return file_review_v1_review_proto_rawDescGZIP(), []int{15}
+	return file_review_v1_review_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListRepliesRequest) GetId() uint64 {
@@ -937,7 +1188,7 @@ type ReplyRecord struct {
 
 func (x *ReplyRecord) Reset() {
 	*x = ReplyRecord{}
-	mi := &file_review_v1_review_proto_msgTypes[16]
+	mi := &file_review_v1_review_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -949,7 +1200,7 @@ func (x *ReplyRecord) String() string {
 func (*ReplyRecord) ProtoMessage() {}
 
 func (x *ReplyRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_review_v1_review_proto_msgTypes[16]
+	mi := &file_review_v1_review_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -962,7 +1213,7 @@ func (x *ReplyRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyRecord.ProtoReflect.Descriptor instead.
 func (*ReplyRecord) Descriptor() ([]byte, []int) {
-	return file_review_v1_review_proto_rawDescGZIP(), []int{16}
+	return file_review_v1_review_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ReplyRecord) GetId() uint64 {
@@ -1009,7 +1260,7 @@ type ListRepliesReply struct {
 
 func (x *ListRepliesReply) Reset() {
 	*x = ListRepliesReply{}
-	mi := &file_review_v1_review_proto_msgTypes[17]
+	mi := &file_review_v1_review_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1021,7 +1272,7 @@ func (x *ListRepliesReply) String() string {
 func (*ListRepliesReply) ProtoMessage() {}
 
 func (x *ListRepliesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_review_v1_review_proto_msgTypes[17]
+	mi := &file_review_v1_review_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1034,7 +1285,7 @@ func (x *ListRepliesReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRepliesReply.ProtoReflect.Descriptor instead.
 func (*ListRepliesReply) Descriptor() ([]byte, []int) {
-	return file_review_v1_review_proto_rawDescGZIP(), []int{17}
+	return file_review_v1_review_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ListRepliesReply) GetReplies() []*ReplyRecord {
@@ -1044,6 +1295,195 @@ func (x *ListRepliesReply) GetReplies() []*ReplyRecord {
 	return nil
 }
 
+type ListAuditHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // review id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditHistoryRequest) Reset() {
+	*x = ListAuditHistoryRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditHistoryRequest) ProtoMessage() {}
+
+func (x *ListAuditHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListAuditHistoryRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// One moderation decision or status change of a review
+type AuditRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewId      uint64                 `protobuf:"varint,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` // approve|reject|appeal|edit|delete|restore
+	FromStatus    string                 `protobuf:"bytes,4,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,5,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	OperatorId    uint64                 `protobuf:"varint,6,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // unix seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditRecord) Reset() {
+	*x = AuditRecord{}
+	mi := &file_review_v1_review_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRecord) ProtoMessage() {}
+
+func (x *AuditRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRecord.ProtoReflect.Descriptor instead.
+func (*AuditRecord) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AuditRecord) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditRecord) GetReviewId() uint64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *AuditRecord) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *AuditRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListAuditHistoryReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*AuditRecord         `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditHistoryReply) Reset() {
+	*x = ListAuditHistoryReply{}
+	mi := &file_review_v1_review_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditHistoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditHistoryReply) ProtoMessage() {}
+
+func (x *ListAuditHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditHistoryReply.ProtoReflect.Descriptor instead.
+func (*ListAuditHistoryReply) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListAuditHistoryReply) GetRecords() []*AuditRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
 type ListPendingReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
@@ -1054,7 +1494,7 @@ type ListPendingReviewRequest struct {
 
 func (x *ListPendingReviewRequest) Reset() {
 	*x = ListPendingReviewRequest{}
-	mi := &file_review_v1_review_proto_msgTypes[18]
+	mi := &file_review_v1_review_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1066,7 +1506,7 @@ func (x *ListPendingReviewRequest) String() string {
 func (*ListPendingReviewRequest) ProtoMessage() {}
 
 func (x *ListPendingReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_review_v1_review_proto_msgTypes[18]
+	mi := &file_review_v1_review_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1079,7 +1519,7 @@ func (x *ListPendingReviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPendingReviewRequest.ProtoReflect.Descriptor instead.
 func (*ListPendingReviewRequest) Descriptor() ([]byte, []int) {
-	return file_review_v1_review_proto_rawDescGZIP(), []int{18}
+	return file_review_v1_review_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ListPendingReviewRequest) GetPage() int32 {
@@ -1106,7 +1546,7 @@ type ListPendingReviewReply struct {
 
 func (x *ListPendingReviewReply) Reset() {
 	*x = ListPendingReviewReply{}
-	mi := &file_review_v1_review_proto_msgTypes[19]
+	mi := &file_review_v1_review_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1118,7 +1558,7 @@ func (x *ListPendingReviewReply) String() string {
 func (*ListPendingReviewReply) ProtoMessage() {}
 
 func (x *ListPendingReviewReply) ProtoReflect() protoreflect.Message {
-	mi := &file_review_v1_review_proto_msgTypes[19]
+	mi := &file_review_v1_review_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,7 +1571,7 @@ func (x *ListPendingReviewReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPendingReviewReply.ProtoReflect.Descriptor instead.
 func (*ListPendingReviewReply) Descriptor() ([]byte, []int) {
-	return file_review_v1_review_proto_rawDescGZIP(), []int{19}
+	return file_review_v1_review_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ListPendingReviewReply) GetTotal() int64 {
@@ -1152,7 +1592,7 @@ var File_review_v1_review_proto protoreflect.FileDescriptor
 
 const file_review_v1_review_proto_rawDesc = "" +
 	"\n" +
-	"\x16review/v1/review.proto\x12\rapi.review.v1\x1a\x1cgoogle/api/annotations.proto\"\x93\x02\n" +
+	"\x16review/v1/review.proto\x12\rapi.review.v1\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\x1a\x17validate/validate.proto\"\xf2\x02\n" +
 	"\fReviewRecord\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x18\n" +
@@ -1165,56 +1605,77 @@ const file_review_v1_review_proto_rawDesc = "" +
 	"\faudit_reason\x18\b \x01(\tR\vauditReason\x12\x19\n" +
 	"\baudit_by\x18\t \x01(\x04R\aauditBy\x12\x19\n" +
 	"\baudit_at\x18\n" +
-	" \x01(\x03R\aauditAt\"z\n" +
+	" \x01(\x03R\aauditAt\x12\x1f\n" +
+	"\vreply_count\x18\v \x01(\x05R\n" +
+	"replyCount\x12\"\n" +
+	"\rlast_reply_at\x18\f \x01(\x03R\vlastReplyAt\x12\x18\n" +
+	"\aversion\x18\r \x01(\x04R\aversion\"\x9c\x01\n" +
 	"\x13CreateReviewRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x18\n" +
-	"\asubject\x18\x02 \x01(\tR\asubject\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\x12\x16\n" +
-	"\x06rating\x18\x04 \x01(\x05R\x06rating\"#\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\"\n" +
+	"\asubject\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\asubject\x12%\n" +
+	"\acontent\x18\x03 \x01(\tB\v\xfaB\br\x06\x10\x01(\xff\xff\x03R\acontent\x12!\n" +
+	"\x06rating\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x05(\x01R\x06rating\"#\n" +
 	"\x11CreateReviewReply\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"q\n" +
-	"\x13UpdateReviewRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x18\n" +
-	"\asubject\x18\x02 \x01(\tR\asubject\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\x12\x16\n" +
-	"\x06rating\x18\x04 \x01(\x05R\x06rating\"\x13\n" +
-	"\x11UpdateReviewReply\"%\n" +
-	"\x13DeleteReviewRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"\x13\n" +
-	"\x11DeleteReviewReply\"\"\n" +
-	"\x10GetReviewRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"E\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\x82\x02\n" +
+	"\x13UpdateReviewRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x12\"\n" +
+	"\asubject\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\asubject\x12#\n" +
+	"\acontent\x18\x03 \x01(\tB\t\xfaB\x06r\x04(\xff\xff\x03R\acontent\x12!\n" +
+	"\x06rating\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x05(\x00R\x06rating\x12;\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12)\n" +
+	"\x10expected_version\x18\x06 \x01(\x04R\x0fexpectedVersion\"\x13\n" +
+	"\x11UpdateReviewReply\".\n" +
+	"\x13DeleteReviewRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"\x13\n" +
+	"\x11DeleteReviewReply\"/\n" +
+	"\x14RestoreReviewRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"\x14\n" +
+	"\x12RestoreReviewReply\"+\n" +
+	"\x10GetReviewRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"E\n" +
 	"\x0eGetReviewReply\x123\n" +
-	"\x06review\x18\x01 \x01(\v2\x1b.api.review.v1.ReviewRecordR\x06review\"\xd3\x01\n" +
-	"\x11ListReviewRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\f\n" +
-	"\x01q\x18\x03 \x01(\tR\x01q\x12\x17\n" +
-	"\auser_id\x18\x04 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\x06review\x18\x01 \x01(\v2\x1b.api.review.v1.ReviewRecordR\x06review\"\xba\x03\n" +
+	"\x11ListReviewRequest\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x16\n" +
+	"\x01q\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x01q\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x04R\x06userId\x12(\n" +
+	"\n" +
+	"rating_min\x18\x05 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x05(\x00R\tratingMin\x12(\n" +
 	"\n" +
-	"rating_min\x18\x05 \x01(\x05R\tratingMin\x12\x1d\n" +
+	"rating_max\x18\x06 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x05(\x00R\tratingMax\x122\n" +
+	"\x04sort\x18\a \x01(\tB\x1e\xfaB\x1br\x19R\x00R\trelevanceR\x02tsR\x06ratingR\x04sort\x12(\n" +
+	"\x05order\x18\b \x01(\tB\x12\xfaB\x0fr\rR\x00R\x03ascR\x04descR\x05order\x12 \n" +
+	"\thas_reply\x18\t \x01(\bH\x00R\bhasReply\x88\x01\x01\x12M\n" +
+	"\bstatuses\x18\n" +
+	" \x03(\tB1\xfaB.\x92\x01+\")r'R\aPENDINGR\bAPPROVEDR\bREJECTEDR\bAPPEALEDR\bstatusesB\f\n" +
 	"\n" +
-	"rating_max\x18\x06 \x01(\x05R\tratingMax\x12\x12\n" +
-	"\x04sort\x18\a \x01(\tR\x04sort\x12\x14\n" +
-	"\x05order\x18\b \x01(\tR\x05order\"^\n" +
+	"_has_reply\"^\n" +
 	"\x0fListReviewReply\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x03R\x05total\x125\n" +
-	"\areviews\x18\x02 \x03(\v2\x1b.api.review.v1.ReviewRecordR\areviews\"y\n" +
-	"\x12AuditReviewRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1a\n" +
-	"\bdecision\x18\x02 \x01(\tR\bdecision\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1f\n" +
+	"\areviews\x18\x02 \x03(\v2\x1b.api.review.v1.ReviewRecordR\areviews\"\xcf\x01\n" +
+	"\x12AuditReviewRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x122\n" +
+	"\bdecision\x18\x02 \x01(\tB\x16\xfaB\x13r\x11R\aAPPROVER\x06REJECTR\bdecision\x12 \n" +
+	"\x06reason\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x04R\x06reason\x12\x1f\n" +
 	"\voperator_id\x18\x04 \x01(\x04R\n" +
-	"operatorId\"\x12\n" +
-	"\x10AuditReviewReply\"_\n" +
-	"\x12CreateReplyRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1f\n" +
+	"operatorId\x12)\n" +
+	"\x10expected_version\x18\x05 \x01(\x04R\x0fexpectedVersion\"\x12\n" +
+	"\x10AuditReviewReply\"i\n" +
+	"\x13AppealReviewRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12 \n" +
+	"\x06reason\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x04R\x06reason\"\x13\n" +
+	"\x11AppealReviewReply\"u\n" +
+	"\x12CreateReplyRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x12\x1f\n" +
 	"\vmerchant_id\x18\x02 \x01(\x04R\n" +
-	"merchantId\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\"\x12\n" +
-	"\x10CreateReplyReply\"$\n" +
-	"\x12ListRepliesRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"\x94\x01\n" +
+	"merchantId\x12%\n" +
+	"\acontent\x18\x03 \x01(\tB\v\xfaB\br\x06\x10\x01(\xff\xff\x03R\acontent\"\x12\n" +
+	"\x10CreateReplyReply\"-\n" +
+	"\x12ListRepliesRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"\x94\x01\n" +
 	"\vReplyRecord\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
 	"\treview_id\x18\x02 \x01(\x04R\breviewId\x12\x1f\n" +
@@ -1224,23 +1685,42 @@ const file_review_v1_review_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"H\n" +
 	"\x10ListRepliesReply\x124\n" +
-	"\areplies\x18\x01 \x03(\v2\x1a.api.review.v1.ReplyRecordR\areplies\"K\n" +
-	"\x18ListPendingReviewRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"e\n" +
+	"\areplies\x18\x01 \x03(\v2\x1a.api.review.v1.ReplyRecordR\areplies\"2\n" +
+	"\x17ListAuditHistoryRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"\xe8\x01\n" +
+	"\vAuditRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
+	"\treview_id\x18\x02 \x01(\x04R\breviewId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x1f\n" +
+	"\vfrom_status\x18\x04 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x05 \x01(\tR\btoStatus\x12\x1f\n" +
+	"\voperator_id\x18\x06 \x01(\x04R\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\"M\n" +
+	"\x15ListAuditHistoryReply\x124\n" +
+	"\arecords\x18\x01 \x03(\v2\x1a.api.review.v1.AuditRecordR\arecords\"_\n" +
+	"\x18ListPendingReviewRequest\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\"e\n" +
 	"\x16ListPendingReviewReply\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x03R\x05total\x125\n" +
-	"\areviews\x18\x02 \x03(\v2\x1b.api.review.v1.ReviewRecordR\areviews2\x89\b\n" +
+	"\areviews\x18\x02 \x03(\v2\x1b.api.review.v1.ReviewRecordR\areviews2\x85\v\n" +
 	"\x06Review\x12l\n" +
 	"\fCreateReview\x12\".api.review.v1.CreateReviewRequest\x1a .api.review.v1.CreateReviewReply\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/reviews\x12q\n" +
 	"\fUpdateReview\x12\".api.review.v1.UpdateReviewRequest\x1a .api.review.v1.UpdateReviewReply\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/reviews/{id}\x12n\n" +
 	"\fDeleteReview\x12\".api.review.v1.DeleteReviewRequest\x1a .api.review.v1.DeleteReviewReply\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/reviews/{id}\x12e\n" +
 	"\tGetReview\x12\x1f.api.review.v1.GetReviewRequest\x1a\x1d.api.review.v1.GetReviewReply\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/reviews/{id}\x12c\n" +
 	"\n" +
-	"ListReview\x12 .api.review.v1.ListReviewRequest\x1a\x1e.api.review.v1.ListReviewReply\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/reviews\x12t\n" +
-	"\vAuditReview\x12!.api.review.v1.AuditReviewRequest\x1a\x1f.api.review.v1.AuditReviewReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/reviews/{id}:audit\x12t\n" +
+	"ListReview\x12 .api.review.v1.ListReviewRequest\x1a\x1e.api.review.v1.ListReviewReply\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/reviews\x12|\n" +
+	"\rRestoreReview\x12#.api.review.v1.RestoreReviewRequest\x1a!.api.review.v1.RestoreReviewReply\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/reviews/{id}:restore\x12t\n" +
+	"\vAuditReview\x12!.api.review.v1.AuditReviewRequest\x1a\x1f.api.review.v1.AuditReviewReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/reviews/{id}:audit\x12x\n" +
+	"\fAppealReview\x12\".api.review.v1.AppealReviewRequest\x1a .api.review.v1.AppealReviewReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/reviews/{id}:appeal\x12t\n" +
 	"\vCreateReply\x12!.api.review.v1.CreateReplyRequest\x1a\x1f.api.review.v1.CreateReplyReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/reviews/{id}:reply\x12s\n" +
-	"\vListReplies\x12!.api.review.v1.ListRepliesRequest\x1a\x1f.api.review.v1.ListRepliesReply\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/reviews/{id}/replies\x12\x80\x01\n" +
+	"\vListReplies\x12!.api.review.v1.ListRepliesRequest\x1a\x1f.api.review.v1.ListRepliesReply\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/reviews/{id}/replies\x12\x81\x01\n" +
+	"\x10ListAuditHistory\x12&.api.review.v1.ListAuditHistoryRequest\x1a$.api.review.v1.ListAuditHistoryReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/reviews/{id}/audits\x12\x80\x01\n" +
 	"\x11ListPendingReview\x12'.api.review.v1.ListPendingReviewRequest\x1a%.api.review.v1.ListPendingReviewReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/reviews:pendingB2\n" +
 	"\rapi.review.v1P\x01Z\x1freview-service/api/review/v1;v1b\x06proto3"
 
@@ -1256,7 +1736,7 @@ func file_review_v1_review_proto_rawDescGZIP() []byte {
 	return file_review_v1_review_proto_rawDescData
 }
 
-var file_review_v1_review_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_review_v1_review_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_review_v1_review_proto_goTypes = []any{
 	(*ReviewRecord)(nil),             // 0: api.review.v1.ReviewRecord
 	(*CreateReviewRequest)(nil),      // 1: api.review.v1.CreateReviewRequest
@@ -1265,48 +1745,64 @@ var file_review_v1_review_proto_goTypes = []any{
 	(*UpdateReviewReply)(nil),        // 4: api.review.v1.UpdateReviewReply
 	(*DeleteReviewRequest)(nil),      // 5: api.review.v1.DeleteReviewRequest
 	(*DeleteReviewReply)(nil),        // 6: api.review.v1.DeleteReviewReply
-	(*GetReviewRequest)(nil),         // 7: api.review.v1.GetReviewRequest
-	(*GetReviewReply)(nil),           // 8: api.review.v1.GetReviewReply
-	(*ListReviewRequest)(nil),        // 9: api.review.v1.ListReviewRequest
-	(*ListReviewReply)(nil),          // 10: api.review.v1.ListReviewReply
-	(*AuditReviewRequest)(nil),       // 11: api.review.v1.AuditReviewRequest
-	(*AuditReviewReply)(nil),         // 12: api.review.v1.AuditReviewReply
-	(*CreateReplyRequest)(nil),       // 13: api.review.v1.CreateReplyRequest
-	(*CreateReplyReply)(nil),         // 14: api.review.v1.CreateReplyReply
-	(*ListRepliesRequest)(nil),       // 15: api.review.v1.ListRepliesRequest
-	(*ReplyRecord)(nil),              // 16: api.review.v1.ReplyRecord
-	(*ListRepliesReply)(nil),         // 17: api.review.v1.ListRepliesReply
-	(*ListPendingReviewRequest)(nil), // 18: api.review.v1.ListPendingReviewRequest
-	(*ListPendingReviewReply)(nil),   // 19: api.review.v1.ListPendingReviewReply
+	(*RestoreReviewRequest)(nil),     // 7: api.review.v1.RestoreReviewRequest
+	(*RestoreReviewReply)(nil),       // 8: api.review.v1.RestoreReviewReply
+	(*GetReviewRequest)(nil),         // 9: api.review.v1.GetReviewRequest
+	(*GetReviewReply)(nil),           // 10: api.review.v1.GetReviewReply
+	(*ListReviewRequest)(nil),        // 11: api.review.v1.ListReviewRequest
+	(*ListReviewReply)(nil),          // 12: api.review.v1.ListReviewReply
+	(*AuditReviewRequest)(nil),       // 13: api.review.v1.AuditReviewRequest
+	(*AuditReviewReply)(nil),         // 14: api.review.v1.AuditReviewReply
+	(*AppealReviewRequest)(nil),      // 15: api.review.v1.AppealReviewRequest
+	(*AppealReviewReply)(nil),        // 16: api.review.v1.AppealReviewReply
+	(*CreateReplyRequest)(nil),       // 17: api.review.v1.CreateReplyRequest
+	(*CreateReplyReply)(nil),         // 18: api.review.v1.CreateReplyReply
+	(*ListRepliesRequest)(nil),       // 19: api.review.v1.ListRepliesRequest
+	(*ReplyRecord)(nil),              // 20: api.review.v1.ReplyRecord
+	(*ListRepliesReply)(nil),         // 21: api.review.v1.ListRepliesReply
+	(*ListAuditHistoryRequest)(nil),  // 22: api.review.v1.ListAuditHistoryRequest
+	(*AuditRecord)(nil),              // 23: api.review.v1.AuditRecord
+	(*ListAuditHistoryReply)(nil),    // 24: api.review.v1.ListAuditHistoryReply
+	(*ListPendingReviewRequest)(nil), // 25: api.review.v1.ListPendingReviewRequest
+	(*ListPendingReviewReply)(nil),   // 26: api.review.v1.ListPendingReviewReply
+	(*fieldmaskpb.FieldMask)(nil),    // 27: google.protobuf.FieldMask
 }
 var file_review_v1_review_proto_depIdxs = []int32{
-	0,  // 0: api.review.v1.GetReviewReply.review:type_name -> api.review.v1.ReviewRecord
-	0,  // 1: api.review.v1.ListReviewReply.reviews:type_name -> api.review.v1.ReviewRecord
-	16, // 2: api.review.v1.ListRepliesReply.replies:type_name -> api.review.v1.ReplyRecord
-	0,  // 3: api.review.v1.ListPendingReviewReply.reviews:type_name -> api.review.v1.ReviewRecord
-	1,  // 4: api.review.v1.Review.CreateReview:input_type -> api.review.v1.CreateReviewRequest
-	3,  // 5: api.review.v1.Review.UpdateReview:input_type -> api.review.v1.UpdateReviewRequest
-	5,  // 6: api.review.v1.Review.DeleteReview:input_type -> api.review.v1.DeleteReviewRequest
-	7,  // 7: api.review.v1.Review.GetReview:input_type -> api.review.v1.GetReviewRequest
-	9,  // 8: api.review.v1.Review.ListReview:input_type -> api.review.v1.ListReviewRequest
-	11, // 9: api.review.v1.Review.AuditReview:input_type -> api.review.v1.AuditReviewRequest
-	13, // 10: api.review.v1.Review.CreateReply:input_type -> api.review.v1.CreateReplyRequest
-	15, // 11: api.review.v1.Review.ListReplies:input_type -> api.review.v1.ListRepliesRequest
-	18, // 12: api.review.v1.Review.ListPendingReview:input_type -> api.review.v1.ListPendingReviewRequest
-	2,  // 13: api.review.v1.Review.CreateReview:output_type -> api.review.v1.CreateReviewReply
-	4,  // 14: api.review.v1.Review.UpdateReview:output_type -> api.review.v1.UpdateReviewReply
-	6,  // 15: api.review.v1.Review.DeleteReview:output_type -> api.review.v1.DeleteReviewReply
-	8,  // 16: api.review.v1.Review.GetReview:output_type -> api.review.v1.GetReviewReply
-	10, // 17: api.review.v1.Review.ListReview:output_type -> api.review.v1.ListReviewReply
-	12, // 18: api.review.v1.Review.AuditReview:output_type -> api.review.v1.AuditReviewReply
-	14, // 19: api.review.v1.Review.CreateReply:output_type -> api.review.v1.CreateReplyReply
-	17, // 20: api.review.v1.Review.ListReplies:output_type -> api.review.v1.ListRepliesReply
-	19, // 21: api.review.v1.Review.ListPendingReview:output_type -> api.review.v1.ListPendingReviewReply
-	13, // [13:22] is the sub-list for method output_type
-	4,  // [4:13] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	27, // 0: api.review.v1.UpdateReviewRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 1: api.review.v1.GetReviewReply.review:type_name -> api.review.v1.ReviewRecord
+	0,  // 2: api.review.v1.ListReviewReply.reviews:type_name -> api.review.v1.ReviewRecord
+	20, // 3: api.review.v1.ListRepliesReply.replies:type_name -> api.review.v1.ReplyRecord
+	23, // 4: api.review.v1.ListAuditHistoryReply.records:type_name -> api.review.v1.AuditRecord
+	0,  // 5: api.review.v1.ListPendingReviewReply.reviews:type_name -> api.review.v1.ReviewRecord
+	1,  // 6: api.review.v1.Review.CreateReview:input_type -> api.review.v1.CreateReviewRequest
+	3,  // 7: api.review.v1.Review.UpdateReview:input_type -> api.review.v1.UpdateReviewRequest
+	5,  // 8: api.review.v1.Review.DeleteReview:input_type -> api.review.v1.DeleteReviewRequest
+	9,  // 9: api.review.v1.Review.GetReview:input_type -> api.review.v1.GetReviewRequest
+	11, // 10: api.review.v1.Review.ListReview:input_type -> api.review.v1.ListReviewRequest
+	7,  // 11: api.review.v1.Review.RestoreReview:input_type -> api.review.v1.RestoreReviewRequest
+	13, // 12: api.review.v1.Review.AuditReview:input_type -> api.review.v1.AuditReviewRequest
+	15, // 13: api.review.v1.Review.AppealReview:input_type -> api.review.v1.AppealReviewRequest
+	17, // 14: api.review.v1.Review.CreateReply:input_type -> api.review.v1.CreateReplyRequest
+	19, // 15: api.review.v1.Review.ListReplies:input_type -> api.review.v1.ListRepliesRequest
+	22, // 16: api.review.v1.Review.ListAuditHistory:input_type -> api.review.v1.ListAuditHistoryRequest
+	25, // 17: api.review.v1.Review.ListPendingReview:input_type -> api.review.v1.ListPendingReviewRequest
+	2,  // 18: api.review.v1.Review.CreateReview:output_type -> api.review.v1.CreateReviewReply
+	4,  // 19: api.review.v1.Review.UpdateReview:output_type -> api.review.v1.UpdateReviewReply
+	6,  // 20: api.review.v1.Review.DeleteReview:output_type -> api.review.v1.DeleteReviewReply
+	10, // 21: api.review.v1.Review.GetReview:output_type -> api.review.v1.GetReviewReply
+	12, // 22: api.review.v1.Review.ListReview:output_type -> api.review.v1.ListReviewReply
+	8,  // 23: api.review.v1.Review.RestoreReview:output_type -> api.review.v1.RestoreReviewReply
+	14, // 24: api.review.v1.Review.AuditReview:output_type -> api.review.v1.AuditReviewReply
+	16, // 25: api.review.v1.Review.AppealReview:output_type -> api.review.v1.AppealReviewReply
+	18, // 26: api.review.v1.Review.CreateReply:output_type -> api.review.v1.CreateReplyReply
+	21, // 27: api.review.v1.Review.ListReplies:output_type -> api.review.v1.ListRepliesReply
+	24, // 28: api.review.v1.Review.ListAuditHistory:output_type -> api.review.v1.ListAuditHistoryReply
+	26, // 29: api.review.v1.Review.ListPendingReview:output_type -> api.review.v1.ListPendingReviewReply
+	18, // [18:30] is the sub-list for method output_type
+	6,  // [6:18] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_review_v1_review_proto_init() }
@@ -1314,13 +1810,14 @@ func file_review_v1_review_proto_init() {
 	if File_review_v1_review_proto != nil {
 		return
 	}
+	file_review_v1_review_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_review_v1_review_proto_rawDesc), len(file_review_v1_review_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,9 +24,12 @@ const (
 	Review_DeleteReview_FullMethodName      = "/api.review.v1.Review/DeleteReview"
 	Review_GetReview_FullMethodName         = "/api.review.v1.Review/GetReview"
 	Review_ListReview_FullMethodName        = "/api.review.v1.Review/ListReview"
+	Review_RestoreReview_FullMethodName     = "/api.review.v1.Review/RestoreReview"
 	Review_AuditReview_FullMethodName       = "/api.review.v1.Review/AuditReview"
+	Review_AppealReview_FullMethodName      = "/api.review.v1.Review/AppealReview"
 	Review_CreateReply_FullMethodName       = "/api.review.v1.Review/CreateReply"
 	Review_ListReplies_FullMethodName       = "/api.review.v1.Review/ListReplies"
+	Review_ListAuditHistory_FullMethodName  = "/api.review.v1.Review/ListAuditHistory"
 	Review_ListPendingReview_FullMethodName = "/api.review.v1.Review/ListPendingReview"
 )
 
@@ -39,12 +42,18 @@ type ReviewClient interface {
 	DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewReply, error)
 	GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*GetReviewReply, error)
 	ListReview(ctx context.Context, in *ListReviewRequest, opts ...grpc.CallOption) (*ListReviewReply, error)
+	// O: 恢复已删除（未清理）的评价
+	RestoreReview(ctx context.Context, in *RestoreReviewRequest, opts ...grpc.CallOption) (*RestoreReviewReply, error)
 	// O: 审核评价
 	AuditReview(ctx context.Context, in *AuditReviewRequest, opts ...grpc.CallOption) (*AuditReviewReply, error)
+	// C: 对被驳回的评价提出申诉（REJECTED -> APPEALED）
+	AppealReview(ctx context.Context, in *AppealReviewRequest, opts ...grpc.CallOption) (*AppealReviewReply, error)
 	// B: 商家回复评价
 	CreateReply(ctx context.Context, in *CreateReplyRequest, opts ...grpc.CallOption) (*CreateReplyReply, error)
 	// B/C: 查看评价回复列表
 	ListReplies(ctx context.Context, in *ListRepliesRequest, opts ...grpc.CallOption) (*ListRepliesReply, error)
+	// O: 评价的审核历史（按时间正序）
+	ListAuditHistory(ctx context.Context, in *ListAuditHistoryRequest, opts ...grpc.CallOption) (*ListAuditHistoryReply, error)
 	// O: 待审核列表
 	ListPendingReview(ctx context.Context, in *ListPendingReviewRequest, opts ...grpc.CallOption) (*ListPendingReviewReply, error)
 }
@@ -107,6 +116,16 @@ func (c *reviewClient) ListReview(ctx context.Context, in *ListReviewRequest, op
 	return out, nil
 }
 
+func (c *reviewClient) RestoreReview(ctx context.Context, in *RestoreReviewRequest, opts ...grpc.CallOption) (*RestoreReviewReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreReviewReply)
+	err := c.cc.Invoke(ctx, Review_RestoreReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reviewClient) AuditReview(ctx context.Context, in *AuditReviewRequest, opts ...grpc.CallOption) (*AuditReviewReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AuditReviewReply)
@@ -117,6 +136,16 @@ func (c *reviewClient) AuditReview(ctx context.Context, in *AuditReviewRequest,
 	return out, nil
 }
 
+func (c *reviewClient) AppealReview(ctx context.Context, in *AppealReviewRequest, opts ...grpc.CallOption) (*AppealReviewReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppealReviewReply)
+	err := c.cc.Invoke(ctx, Review_AppealReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reviewClient) CreateReply(ctx context.Context, in *CreateReplyRequest, opts ...grpc.CallOption) (*CreateReplyReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateReplyReply)
@@ -137,6 +166,16 @@ func (c *reviewClient) ListReplies(ctx context.Context, in *ListRepliesRequest,
 	return out, nil
 }
 
+func (c *reviewClient) ListAuditHistory(ctx context.Context, in *ListAuditHistoryRequest, opts ...grpc.CallOption) (*ListAuditHistoryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditHistoryReply)
+	err := c.cc.Invoke(ctx, Review_ListAuditHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reviewClient) ListPendingReview(ctx context.Context, in *ListPendingReviewRequest, opts ...grpc.CallOption) (*ListPendingReviewReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPendingReviewReply)
@@ -156,12 +195,18 @@ type ReviewServer interface {
 	DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewReply, error)
 	GetReview(context.Context, *GetReviewRequest) (*GetReviewReply, error)
 	ListReview(context.Context, *ListReviewRequest) (*ListReviewReply, error)
+	// O: 恢复已删除（未清理）的评价
+	RestoreReview(context.Context, *RestoreReviewRequest) (*RestoreReviewReply, error)
 	// O: 审核评价
 	AuditReview(context.Context, *AuditReviewRequest) (*AuditReviewReply, error)
+	// C: 对被驳回的评价提出申诉（REJECTED -> APPEALED）
+	AppealReview(context.Context, *AppealReviewRequest) (*AppealReviewReply, error)
 	// B: 商家回复评价
 	CreateReply(context.Context, *CreateReplyRequest) (*CreateReplyReply, error)
 	// B/C: 查看评价回复列表
 	ListReplies(context.Context, *ListRepliesRequest) (*ListRepliesReply, error)
+	// O: 评价的审核历史（按时间正序）
+	ListAuditHistory(context.Context, *ListAuditHistoryRequest) (*ListAuditHistoryReply, error)
 	// O: 待审核列表
 	ListPendingReview(context.Context, *ListPendingReviewRequest) (*ListPendingReviewReply, error)
 	mustEmbedUnimplementedReviewServer()
@@ -189,15 +234,24 @@ func (UnimplementedReviewServer) GetReview(context.Context, *GetReviewRequest) (
 func (UnimplementedReviewServer) ListReview(context.Context, *ListReviewRequest) (*ListReviewReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReview not implemented")
 }
+func (UnimplementedReviewServer) RestoreReview(context.Context, *RestoreReviewRequest) (*RestoreReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreReview not implemented")
+}
 func (UnimplementedReviewServer) AuditReview(context.Context, *AuditReviewRequest) (*AuditReviewReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuditReview not implemented")
 }
+func (UnimplementedReviewServer) AppealReview(context.Context, *AppealReviewRequest) (*AppealReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealReview not implemented")
+}
 func (UnimplementedReviewServer) CreateReply(context.Context, *CreateReplyRequest) (*CreateReplyReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateReply not implemented")
 }
 func (UnimplementedReviewServer) ListReplies(context.Context, *ListRepliesRequest) (*ListRepliesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReplies not implemented")
 }
+func (UnimplementedReviewServer) ListAuditHistory(context.Context, *ListAuditHistoryRequest) (*ListAuditHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditHistory not implemented")
+}
 func (UnimplementedReviewServer) ListPendingReview(context.Context, *ListPendingReviewRequest) (*ListPendingReviewReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPendingReview not implemented")
 }
@@ -312,6 +366,24 @@ func _Review_ListReview_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Review_RestoreReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).RestoreReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_RestoreReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).RestoreReview(ctx, req.(*RestoreReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Review_AuditReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AuditReviewRequest)
 	if err := dec(in); err != nil {
@@ -330,6 +402,24 @@ func _Review_AuditReview_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Review_AppealReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppealReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).AppealReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_AppealReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).AppealReview(ctx, req.(*AppealReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Review_CreateReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateReplyRequest)
 	if err := dec(in); err != nil {
@@ -366,6 +456,24 @@ func _Review_ListReplies_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Review_ListAuditHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ListAuditHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ListAuditHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ListAuditHistory(ctx, req.(*ListAuditHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Review_ListPendingReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPendingReviewRequest)
 	if err := dec(in); err != nil {
@@ -411,10 +519,18 @@ var Review_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListReview",
 			Handler:    _Review_ListReview_Handler,
 		},
+		{
+			MethodName: "RestoreReview",
+			Handler:    _Review_RestoreReview_Handler,
+		},
 		{
 			MethodName: "AuditReview",
 			Handler:    _Review_AuditReview_Handler,
 		},
+		{
+			MethodName: "AppealReview",
+			Handler:    _Review_AppealReview_Handler,
+		},
 		{
 			MethodName: "CreateReply",
 			Handler:    _Review_CreateReply_Handler,
@@ -423,6 +539,10 @@ var Review_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListReplies",
 			Handler:    _Review_ListReplies_Handler,
 		},
+		{
+			MethodName: "ListAuditHistory",
+			Handler:    _Review_ListAuditHistory_Handler,
+		},
 		{
 			MethodName: "ListPendingReview",
 			Handler:    _Review_ListPendingReview_Handler,
@@ -19,17 +19,22 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationReviewAppealReview = "/api.review.v1.Review/AppealReview"
 const OperationReviewAuditReview = "/api.review.v1.Review/AuditReview"
 const OperationReviewCreateReply = "/api.review.v1.Review/CreateReply"
 const OperationReviewCreateReview = "/api.review.v1.Review/CreateReview"
 const OperationReviewDeleteReview = "/api.review.v1.Review/DeleteReview"
 const OperationReviewGetReview = "/api.review.v1.Review/GetReview"
+const OperationReviewListAuditHistory = "/api.review.v1.Review/ListAuditHistory"
 const OperationReviewListPendingReview = "/api.review.v1.Review/ListPendingReview"
 const OperationReviewListReplies = "/api.review.v1.Review/ListReplies"
 const OperationReviewListReview = "/api.review.v1.Review/ListReview"
+const OperationReviewRestoreReview = "/api.review.v1.Review/RestoreReview"
 const OperationReviewUpdateReview = "/api.review.v1.Review/UpdateReview"
 
 type ReviewHTTPServer interface {
+	// AppealReview C: 对被驳回的评价提出申诉（REJECTED -> APPEALED）
+	AppealReview(context.Context, *AppealReviewRequest) (*AppealReviewReply, error)
 	// AuditReview O: 审核评价
 	AuditReview(context.Context, *AuditReviewRequest) (*AuditReviewReply, error)
 	// CreateReply B: 商家回复评价
@@ -37,11 +42,15 @@ type ReviewHTTPServer interface {
 	CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewReply, error)
 	DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewReply, error)
 	GetReview(context.Context, *GetReviewRequest) (*GetReviewReply, error)
+	// ListAuditHistory O: 评价的审核历史（按时间正序）
+	ListAuditHistory(context.Context, *ListAuditHistoryRequest) (*ListAuditHistoryReply, error)
 	// ListPendingReview O: 待审核列表
 	ListPendingReview(context.Context, *ListPendingReviewRequest) (*ListPendingReviewReply, error)
 	// ListReplies B/C: 查看评价回复列表
 	ListReplies(context.Context, *ListRepliesRequest) (*ListRepliesReply, error)
 	ListReview(context.Context, *ListReviewRequest) (*ListReviewReply, error)
+	// RestoreReview O: 恢复已删除（未清理）的评价
+	RestoreReview(context.Context, *RestoreReviewRequest) (*RestoreReviewReply, error)
 	UpdateReview(context.Context, *UpdateReviewRequest) (*UpdateReviewReply, error)
 }
 
@@ -52,9 +61,12 @@ func RegisterReviewHTTPServer(s *http.Server, srv ReviewHTTPServer) {
 	r.DELETE("/v1/reviews/{id}", _Review_DeleteReview0_HTTP_Handler(srv))
 	r.GET("/v1/reviews/{id}", _Review_GetReview0_HTTP_Handler(srv))
 	r.GET("/v1/reviews", _Review_ListReview0_HTTP_Handler(srv))
+	r.POST("/v1/reviews/{id}:restore", _Review_RestoreReview0_HTTP_Handler(srv))
 	r.POST("/v1/reviews/{id}:audit", _Review_AuditReview0_HTTP_Handler(srv))
+	r.POST("/v1/reviews/{id}:appeal", _Review_AppealReview0_HTTP_Handler(srv))
 	r.POST("/v1/reviews/{id}:reply", _Review_CreateReply0_HTTP_Handler(srv))
 	r.GET("/v1/reviews/{id}/replies", _Review_ListReplies0_HTTP_Handler(srv))
+	r.GET("/v1/reviews/{id}/audits", _Review_ListAuditHistory0_HTTP_Handler(srv))
 	r.GET("/v1/reviews:pending", _Review_ListPendingReview0_HTTP_Handler(srv))
 }
 
@@ -168,6 +180,31 @@ func _Review_ListReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Contex
 	}
 }
 
+func _Review_RestoreReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewRestoreReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreReview(ctx, req.(*RestoreReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RestoreReviewReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Review_AuditReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in AuditReviewRequest
@@ -193,6 +230,31 @@ func _Review_AuditReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Conte
 	}
 }
 
+func _Review_AppealReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AppealReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewAppealReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AppealReview(ctx, req.(*AppealReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AppealReviewReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Review_CreateReply0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in CreateReplyRequest
@@ -240,6 +302,28 @@ func _Review_ListReplies0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Conte
 	}
 }
 
+func _Review_ListAuditHistory0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAuditHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewListAuditHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAuditHistory(ctx, req.(*ListAuditHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAuditHistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Review_ListPendingReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ListPendingReviewRequest
@@ -260,6 +344,8 @@ func _Review_ListPendingReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http
 }
 
 type ReviewHTTPClient interface {
+	// AppealReview C: 对被驳回的评价提出申诉（REJECTED -> APPEALED）
+	AppealReview(ctx context.Context, req *AppealReviewRequest, opts ...http.CallOption) (rsp *AppealReviewReply, err error)
 	// AuditReview O: 审核评价
 	AuditReview(ctx context.Context, req *AuditReviewRequest, opts ...http.CallOption) (rsp *AuditReviewReply, err error)
 	// CreateReply B: 商家回复评价
@@ -267,11 +353,15 @@ type ReviewHTTPClient interface {
 	CreateReview(ctx context.Context, req *CreateReviewRequest, opts ...http.CallOption) (rsp *CreateReviewReply, err error)
 	DeleteReview(ctx context.Context, req *DeleteReviewRequest, opts ...http.CallOption) (rsp *DeleteReviewReply, err error)
 	GetReview(ctx context.Context, req *GetReviewRequest, opts ...http.CallOption) (rsp *GetReviewReply, err error)
+	// ListAuditHistory O: 评价的审核历史（按时间正序）
+	ListAuditHistory(ctx context.Context, req *ListAuditHistoryRequest, opts ...http.CallOption) (rsp *ListAuditHistoryReply, err error)
 	// ListPendingReview O: 待审核列表
 	ListPendingReview(ctx context.Context, req *ListPendingReviewRequest, opts ...http.CallOption) (rsp *ListPendingReviewReply, err error)
 	// ListReplies B/C: 查看评价回复列表
 	ListReplies(ctx context.Context, req *ListRepliesRequest, opts ...http.CallOption) (rsp *ListRepliesReply, err error)
 	ListReview(ctx context.Context, req *ListReviewRequest, opts ...http.CallOption) (rsp *ListReviewReply, err error)
+	// RestoreReview O: 恢复已删除（未清理）的评价
+	RestoreReview(ctx context.Context, req *RestoreReviewRequest, opts ...http.CallOption) (rsp *RestoreReviewReply, err error)
 	UpdateReview(ctx context.Context, req *UpdateReviewRequest, opts ...http.CallOption) (rsp *UpdateReviewReply, err error)
 }
 
@@ -283,6 +373,20 @@ func NewReviewHTTPClient(client *http.Client) ReviewHTTPClient {
 	return &ReviewHTTPClientImpl{client}
 }
 
+// AppealReview C: 对被驳回的评价提出申诉（REJECTED -> APPEALED）
+func (c *ReviewHTTPClientImpl) AppealReview(ctx context.Context, in *AppealReviewRequest, opts ...http.CallOption) (*AppealReviewReply, error) {
+	var out AppealReviewReply
+	pattern := "/v1/reviews/{id}:appeal"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewAppealReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // AuditReview O: 审核评价
 func (c *ReviewHTTPClientImpl) AuditReview(ctx context.Context, in *AuditReviewRequest, opts ...http.CallOption) (*AuditReviewReply, error) {
 	var out AuditReviewReply
@@ -350,6 +454,20 @@ func (c *ReviewHTTPClientImpl) GetReview(ctx context.Context, in *GetReviewReque
 	return &out, nil
 }
 
+// ListAuditHistory O: 评价的审核历史（按时间正序）
+func (c *ReviewHTTPClientImpl) ListAuditHistory(ctx context.Context, in *ListAuditHistoryRequest, opts ...http.CallOption) (*ListAuditHistoryReply, error) {
+	var out ListAuditHistoryReply
+	pattern := "/v1/reviews/{id}/audits"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewListAuditHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListPendingReview O: 待审核列表
 func (c *ReviewHTTPClientImpl) ListPendingReview(ctx context.Context, in *ListPendingReviewRequest, opts ...http.CallOption) (*ListPendingReviewReply, error) {
 	var out ListPendingReviewReply
@@ -391,6 +509,20 @@ func (c *ReviewHTTPClientImpl) ListReview(ctx context.Context, in *ListReviewReq
 	return &out, nil
 }
 
+// RestoreReview O: 恢复已删除（未清理）的评价
+func (c *ReviewHTTPClientImpl) RestoreReview(ctx context.Context, in *RestoreReviewRequest, opts ...http.CallOption) (*RestoreReviewReply, error) {
+	var out RestoreReviewReply
+	pattern := "/v1/reviews/{id}:restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewRestoreReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ReviewHTTPClientImpl) UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...http.CallOption) (*UpdateReviewReply, error) {
 	var out UpdateReviewReply
 	pattern := "/v1/reviews/{id}"
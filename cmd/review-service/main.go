@@ -1,10 +1,16 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
 	"os"
+	"time"
 
 	"review-service/internal/conf"
+	"review-service/internal/data"
+	"review-service/internal/server"
+	"review-service/internal/telemetry"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -31,9 +37,13 @@ var (
 
 func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [-conf path] [migrate up|down [n]|status|force <version> [applied|pending]]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, relay *data.OutboxRelay, purger *data.ReviewPurger, health *server.Health) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -43,10 +53,21 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			relay,
+			purger,
+			health,
 		),
 	)
 }
 
+// serviceName is Name, or review-service when the build did not set it.
+func serviceName() string {
+	if Name == "" {
+		return "review-service"
+	}
+	return Name
+}
+
 func main() {
 	flag.Parse()
 	logger := log.With(log.NewStdLogger(os.Stdout),
@@ -74,6 +95,23 @@ func main() {
 		panic(err)
 	}
 
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrate(bc.Data, logger, flag.Args()[1:]); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	shutdownTracing, err := telemetry.Setup(context.Background(), bc.Trace, serviceName(), Version, id)
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = shutdownTracing(ctx)
+	}()
+
 	app, cleanup, err := wireApp(bc.Server, bc.Data, logger)
 	if err != nil {
 		panic(err)
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"review-service/internal/conf"
+	"review-service/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// runMigrate handles `review-service migrate up|down [n]|status|force <version> [applied|pending]`.
+func runMigrate(c *conf.Data, logger log.Logger, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: migrate up|down [n]|status|force <version> [applied|pending]")
+	}
+	db, err := sql.Open(c.Database.Driver, c.Database.Source)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	m, err := data.NewMigrator(db, logger)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		n, err := m.Up(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("applied %d migration(s)\n", n)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return fmt.Errorf("invalid step count %q", args[1])
+			}
+		}
+		n, err := m.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("rolled back %d migration(s)\n", n)
+	case "status":
+		list, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, st := range list {
+			applied := "pending"
+			switch {
+			case st.Dirty:
+				applied = "dirty"
+			case st.AppliedAt != nil:
+				applied = st.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d  %-40s %s\n", st.Version, st.Name, applied)
+		}
+	case "force":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: migrate force <version> [applied|pending]")
+		}
+		version, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		state := "applied"
+		if len(args) > 2 {
+			state = args[2]
+		}
+		if state != "applied" && state != "pending" {
+			return fmt.Errorf("invalid state %q, want applied or pending", state)
+		}
+		if err := m.Force(ctx, version, state == "applied"); err != nil {
+			return err
+		}
+		fmt.Printf("migration %04d marked %s\n", version, state)
+	default:
+		return fmt.Errorf("unknown migrate command %q", args[0])
+	}
+	return nil
+}
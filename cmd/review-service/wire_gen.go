@@ -33,12 +33,36 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
     greeterService := service.NewGreeterService(greeterUsecase)
 
     reviewRepo := data.NewReviewRepo(dataData, logger)
-    reviewUsecase := biz.NewReviewUsecase(reviewRepo, logger)
+    reviewSearcher, err := data.NewReviewSearcher(confData, dataData, logger)
+    if err != nil {
+        cleanup()
+        return nil, nil, err
+    }
+    reviewUsecase := biz.NewReviewUsecase(reviewRepo, reviewSearcher, logger)
     reviewService := service.NewReviewService(reviewUsecase)
 
-    grpcServer := server.NewGRPCServer(confServer, greeterService, reviewService, logger)
-    httpServer := server.NewHTTPServer(confServer, greeterService, reviewService, logger)
-    app := newApp(logger, grpcServer, httpServer)
+    auth, err := server.NewAuth(confServer, logger)
+    if err != nil {
+        cleanup()
+        return nil, nil, err
+    }
+    metrics, err := server.NewMetrics()
+    if err != nil {
+        cleanup()
+        return nil, nil, err
+    }
+    healthRepo := data.NewHealthRepo(dataData)
+    healthUsecase := biz.NewHealthUsecase(healthRepo, logger)
+    health, err := server.NewHealth(confServer, healthUsecase, logger)
+    if err != nil {
+        cleanup()
+        return nil, nil, err
+    }
+    grpcServer := server.NewGRPCServer(confServer, greeterService, reviewService, auth, metrics, health, logger)
+    httpServer := server.NewHTTPServer(confServer, greeterService, reviewService, auth, metrics, health, logger)
+    outboxRelay := data.NewOutboxRelay(dataData, confData, logger)
+    reviewPurger := data.NewReviewPurger(dataData, confData, logger)
+    app := newApp(logger, grpcServer, httpServer, outboxRelay, reviewPurger, health)
     return app, func() {
         cleanup()
     }, nil
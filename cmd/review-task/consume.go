@@ -0,0 +1,372 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	esv8 "github.com/elastic/go-elasticsearch/v8"
+	kafka "github.com/segmentio/kafka-go"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	eventsv1 "review-service/api/review/events/v1"
+	"review-service/internal/conf"
+	"review-service/internal/data"
+)
+
+const (
+	defaultGroupID       = "review-task"
+	defaultBatchSize     = 500
+	defaultBatchBytes    = 5 << 20
+	defaultFlushInterval = time.Second
+	defaultMaxRetries    = 5
+	defaultRetryBackoff  = time.Second
+	defaultMaxBackoff    = 30 * time.Second
+	shutdownFlushTimeout = 10 * time.Second
+)
+
+// messageReader is the part of *kafka.Reader the consumer uses.
+type messageReader interface {
+	FetchMessage(context.Context) (kafka.Message, error)
+	CommitMessages(context.Context, ...kafka.Message) error
+	Close() error
+}
+
+// messageWriter publishes messages; *kafka.Writer implements it.
+type messageWriter interface {
+	WriteMessages(context.Context, ...kafka.Message) error
+	Close() error
+}
+
+// bulkIndexer sends _bulk requests to the indices behind the write alias.
+type bulkIndexer interface {
+	WriteIndices(context.Context) ([]string, error)
+	Bulk(context.Context, []data.ESBulkOp) ([]data.ESBulkResult, error)
+}
+
+// esIndexer is the bulkIndexer of a live cluster.
+type esIndexer struct {
+	*data.ESIndexManager
+	es *esv8.Client
+}
+
+func (i esIndexer) Bulk(ctx context.Context, ops []data.ESBulkOp) ([]data.ESBulkResult, error) {
+	return data.ESBulk(ctx, i.es, ops)
+}
+
+// consumer syncs review events from Kafka into Elasticsearch. Messages are
+// fetched without committing, indexed in _bulk batches, and their offsets are
+// committed only once ES has acknowledged the whole batch, so a crash replays
+// the batch instead of losing it.
+type consumer struct {
+	reader messageReader
+	index  bulkIndexer
+	dlq    messageWriter
+
+	batchSize     int
+	batchBytes    int
+	flushInterval time.Duration
+	maxRetries    int
+	retryBackoff  time.Duration
+	maxBackoff    time.Duration
+}
+
+func newConsumer(bc *conf.Bootstrap, es *esv8.Client, indexes *data.ESIndexManager) *consumer {
+	c := &consumer{
+		index:         esIndexer{ESIndexManager: indexes, es: es},
+		batchSize:     defaultBatchSize,
+		batchBytes:    defaultBatchBytes,
+		flushInterval: defaultFlushInterval,
+		maxRetries:    defaultMaxRetries,
+		retryBackoff:  defaultRetryBackoff,
+		maxBackoff:    defaultMaxBackoff,
+	}
+	if t := bc.Task; t != nil {
+		if t.BatchSize > 0 {
+			c.batchSize = int(t.BatchSize)
+		}
+		if t.BatchBytes > 0 {
+			c.batchBytes = int(t.BatchBytes)
+		}
+		if t.FlushInterval != nil && t.FlushInterval.AsDuration() > 0 {
+			c.flushInterval = t.FlushInterval.AsDuration()
+		}
+		if t.MaxRetries > 0 {
+			c.maxRetries = int(t.MaxRetries)
+		}
+		if t.RetryBackoff != nil && t.RetryBackoff.AsDuration() > 0 {
+			c.retryBackoff = t.RetryBackoff.AsDuration()
+		}
+		if t.MaxRetryBackoff != nil && t.MaxRetryBackoff.AsDuration() > 0 {
+			c.maxBackoff = t.MaxRetryBackoff.AsDuration()
+		}
+	}
+	c.dlq = newDeadLetterWriter(bc)
+	c.reader = kafka.NewReader(kafka.ReaderConfig{
+		Brokers:  bc.Data.Kafka.Brokers,
+		GroupID:  groupID(bc),
+		Topic:    bc.Data.Kafka.Topic,
+		MinBytes: 10e3, // 10KB
+		MaxBytes: 10e6, // 10MB
+		MaxWait:  c.flushInterval,
+	})
+	return c
+}
+
+// groupID is the consumer group of the review topic, task.group_id or
+// defaultGroupID.
+func groupID(bc *conf.Bootstrap) string {
+	if g := bc.Task.GetGroupId(); g != "" {
+		return g
+	}
+	return defaultGroupID
+}
+
+func (c *consumer) Close() error {
+	c.dlq.Close()
+	return c.reader.Close()
+}
+
+// run consumes until ctx is done, then flushes what is buffered. A batch
+// that fails to flush is retried until it succeeds: commits are per
+// partition, so committing a later batch would skip the failed events. No
+// newer message is taken in the meantime.
+func (c *consumer) run(ctx context.Context) {
+	msgs := make(chan kafka.Message)
+	go c.fetch(ctx, msgs)
+
+	var (
+		batch []kafka.Message
+		size  int
+		timer = time.NewTimer(c.flushInterval)
+	)
+	timer.Stop()
+	flush := func(ctx context.Context) {
+		backoff := c.retryBackoff
+		for len(batch) > 0 {
+			err := c.flush(ctx, batch)
+			if err == nil {
+				batch, size = batch[:0], 0
+				return
+			}
+			log.Printf("flush %d messages: %v; retrying in %s", len(batch), err, backoff)
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				// left uncommitted: consumed again from the last committed
+				// offset after a restart
+				return
+			}
+			if backoff *= 2; backoff > c.maxBackoff {
+				backoff = c.maxBackoff
+			}
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			fctx, cancel := context.WithTimeout(context.Background(), shutdownFlushTimeout)
+			flush(fctx)
+			cancel()
+			log.Printf("context done: %v", ctx.Err())
+			return
+		case m := <-msgs:
+			if len(batch) == 0 {
+				timer.Reset(c.flushInterval)
+			}
+			batch = append(batch, m)
+			size += len(m.Value)
+			if len(batch) >= c.batchSize || size >= c.batchBytes {
+				timer.Stop()
+				flush(ctx)
+			}
+		case <-timer.C:
+			flush(ctx)
+		}
+	}
+}
+
+func (c *consumer) fetch(ctx context.Context, out chan<- kafka.Message) {
+	for {
+		m, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("fetch message error: %v", err)
+			time.Sleep(time.Second)
+			continue
+		}
+		observeFetch(m)
+		select {
+		case out <- m:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// flush indexes batch and commits its offsets. Transport errors and
+// retryable item failures (429, 5xx) are retried up to maxRetries times with
+// exponential backoff; events that still fail, are rejected by ES or cannot be
+// decoded go to the dead-letter topic. Offsets are committed only once every
+// event is either indexed or dead-lettered.
+func (c *consumer) flush(ctx context.Context, batch []kafka.Message) (err error) {
+	dead := map[int]*deadLetter{}
+	spans := startProcessSpans(ctx, batch)
+	ctx, span := tracer.Start(ctx, "review-task flush",
+		trace.WithLinks(spanLinks(spans)...),
+		trace.WithAttributes(semconv.MessagingBatchMessageCount(len(batch))),
+	)
+	defer func() {
+		endProcessSpans(spans, dead, err)
+		endSpan(span, err)
+	}()
+
+	targets, err := c.index.WriteIndices(ctx)
+	if err != nil {
+		return fmt.Errorf("resolve write alias: %w", err)
+	}
+	var (
+		ops   []data.ESBulkOp
+		owner []int // index in batch of each op
+	)
+	for i, m := range batch {
+		evtOps, err := c.eventOps(m, targets)
+		if err != nil {
+			dead[i] = &deadLetter{Err: err.Error(), Attempts: 1}
+			continue
+		}
+		for _, op := range evtOps {
+			ops, owner = append(ops, op), append(owner, i)
+		}
+	}
+
+	backoff := c.retryBackoff
+	lastErr := map[int]string{}
+	for attempt := 1; len(ops) > 0; attempt++ {
+		results, err := c.index.Bulk(ctx, ops)
+		var retryOps []data.ESBulkOp
+		var retryOwner []int
+		retry := func(i int, reason string) {
+			lastErr[owner[i]] = reason
+			retryOps, retryOwner = append(retryOps, ops[i]), append(retryOwner, owner[i])
+		}
+		if err != nil {
+			log.Printf("es bulk error (attempt %d): %v", attempt, err)
+			for i := range ops {
+				retry(i, err.Error())
+			}
+		}
+		for i, r := range results {
+			switch {
+			case !r.Failed(), ops[i].Action == "delete" && r.Status == http.StatusNotFound:
+			case r.Status == http.StatusConflict && ops[i].Version > 0:
+				// stale: ES already has this or a newer version of the review
+			case r.Status == http.StatusTooManyRequests || r.Status >= 500:
+				retry(i, fmt.Sprintf("es %s %s: %d %s", ops[i].Action, ops[i].Index, r.Status, r.Error))
+			default:
+				dead[owner[i]] = &deadLetter{Err: fmt.Sprintf("es %s %s: %d %s", ops[i].Action, ops[i].Index, r.Status, r.Error), Attempts: attempt}
+			}
+		}
+		// an event already dead-lettered is not retried on its other targets
+		ops, owner = ops[:0], owner[:0]
+		for i, op := range retryOps {
+			if dead[retryOwner[i]] == nil {
+				ops, owner = append(ops, op), append(owner, retryOwner[i])
+			}
+		}
+		if len(ops) == 0 {
+			break
+		}
+		if attempt > c.maxRetries {
+			for _, i := range owner {
+				dead[i] = &deadLetter{Err: lastErr[i], Attempts: attempt}
+			}
+			break
+		}
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if backoff *= 2; backoff > c.maxBackoff {
+			backoff = c.maxBackoff
+		}
+	}
+
+	if len(dead) > 0 {
+		letters := make([]kafka.Message, 0, len(dead))
+		for i, m := range batch {
+			if d := dead[i]; d != nil {
+				log.Printf("dead-letter %s/%d/%d after %d attempts: %s", m.Topic, m.Partition, m.Offset, d.Attempts, d.Err)
+				letters = append(letters, d.message(m))
+			}
+		}
+		if err := c.writeDeadLetters(ctx, letters); err != nil {
+			return fmt.Errorf("write dead letters: %w", err)
+		}
+	}
+	if err := c.reader.CommitMessages(ctx, batch...); err != nil {
+		return err
+	}
+	eventsIndexed.Add(float64(len(batch) - len(dead)))
+	eventsFailed.Add(float64(len(dead)))
+	return nil
+}
+
+// writeDeadLetters publishes letters, retrying with backoff until the write
+// succeeds or ctx is done. Events that were neither indexed nor dead-lettered
+// must not be committed, so a failing dead-letter topic blocks the consumer.
+func (c *consumer) writeDeadLetters(ctx context.Context, letters []kafka.Message) error {
+	backoff := c.retryBackoff
+	for {
+		err := c.dlq.WriteMessages(ctx, letters...)
+		if err == nil {
+			return nil
+		}
+		log.Printf("write %d dead letters: %v; retrying in %s", len(letters), err, backoff)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return err
+		}
+		if backoff *= 2; backoff > c.maxBackoff {
+			backoff = c.maxBackoff
+		}
+	}
+}
+
+// eventOps turns one message into bulk ops against every index behind the
+// write alias. Every event indexes the full snapshot, or deletes it, at the
+// review's version, so ES drops events older than what it already has.
+// Unknown types yield none; undecodable events are an error.
+func (c *consumer) eventOps(m kafka.Message, targets []string) ([]data.ESBulkOp, error) {
+	evt, err := data.UnmarshalReviewEvent(m.Value)
+	if err != nil {
+		return nil, err
+	}
+	id := fmt.Sprint(evt.AggregateId)
+	rev := data.ReviewFromSnapshot(evt.Review)
+	ts := evt.OccurredAt.AsTime().Unix()
+	var ops []data.ESBulkOp
+	for _, target := range targets {
+		op := data.ESBulkOp{Index: target, ID: id, Version: evt.AggregateVersion}
+		switch evt.Type {
+		case eventsv1.EventType_REVIEW_CREATED, eventsv1.EventType_REVIEW_UPDATED,
+			eventsv1.EventType_REVIEW_AUDITED, eventsv1.EventType_REVIEW_APPEALED,
+			eventsv1.EventType_REVIEW_REPLIED, eventsv1.EventType_REVIEW_RESTORED:
+			op.Action, op.Doc = "index", data.ReviewDocument(rev, ts)
+		case eventsv1.EventType_REVIEW_DELETED, eventsv1.EventType_REVIEW_PURGED:
+			op.Action = "delete"
+		default:
+			continue
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	kafka "github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	eventsv1 "review-service/api/review/events/v1"
+	"review-service/internal/conf"
+	"review-service/internal/data"
+)
+
+// journal records the side effects of a flush in order.
+type journal struct {
+	mu      sync.Mutex
+	entries []string
+}
+
+func (j *journal) add(format string, args ...any) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.entries = append(j.entries, fmt.Sprintf(format, args...))
+}
+
+func (j *journal) list() []string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return slices.Clone(j.entries)
+}
+
+// fakeReader hands out msgs and records commits.
+type fakeReader struct {
+	j    *journal
+	msgs chan kafka.Message
+}
+
+func (r *fakeReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	select {
+	case m := <-r.msgs:
+		return m, nil
+	case <-ctx.Done():
+		return kafka.Message{}, ctx.Err()
+	}
+}
+
+func (r *fakeReader) CommitMessages(_ context.Context, msgs ...kafka.Message) error {
+	for _, m := range msgs {
+		r.j.add("commit %d", m.Offset)
+	}
+	return nil
+}
+
+func (r *fakeReader) Close() error { return nil }
+
+// fakeWriter is the dead-letter topic; it fails while fail is set.
+type fakeWriter struct {
+	j    *journal
+	fail bool
+	msgs []kafka.Message
+}
+
+func (w *fakeWriter) WriteMessages(_ context.Context, msgs ...kafka.Message) error {
+	if w.fail {
+		return errors.New("dlq unavailable")
+	}
+	for _, m := range msgs {
+		w.j.add("dlq %s", header(m, headerDLQSource))
+	}
+	w.msgs = append(w.msgs, msgs...)
+	return nil
+}
+
+func (w *fakeWriter) Close() error { return nil }
+
+// fakeIndexer answers each _bulk request with respond; a nil respond indexes
+// everything.
+type fakeIndexer struct {
+	j          *journal
+	indicesErr error
+	// WriteIndices fails this many times first
+	indicesDown int
+	respond     func(call int, ops []data.ESBulkOp) ([]data.ESBulkResult, error)
+	calls       int
+}
+
+func (i *fakeIndexer) WriteIndices(context.Context) ([]string, error) {
+	if i.indicesDown > 0 {
+		i.indicesDown--
+		i.j.add("es down")
+		return nil, errors.New("es down")
+	}
+	if i.indicesErr != nil {
+		return nil, i.indicesErr
+	}
+	return []string{"reviews-v3-initial"}, nil
+}
+
+func (i *fakeIndexer) Bulk(_ context.Context, ops []data.ESBulkOp) ([]data.ESBulkResult, error) {
+	i.calls++
+	if i.respond != nil {
+		return i.respond(i.calls, ops)
+	}
+	return allStatus(ops, http.StatusOK), nil
+}
+
+func allStatus(ops []data.ESBulkOp, status int) []data.ESBulkResult {
+	out := make([]data.ESBulkResult, len(ops))
+	for k := range out {
+		out[k] = data.ESBulkResult{Status: status}
+		if status >= 300 {
+			out[k].Error = http.StatusText(status)
+		}
+	}
+	return out
+}
+
+func newTestConsumer(j *journal) (*consumer, *fakeReader, *fakeWriter, *fakeIndexer) {
+	r := &fakeReader{j: j, msgs: make(chan kafka.Message)}
+	w := &fakeWriter{j: j}
+	idx := &fakeIndexer{j: j}
+	return &consumer{
+		reader:        r,
+		index:         idx,
+		dlq:           w,
+		batchSize:     2,
+		batchBytes:    defaultBatchBytes,
+		flushInterval: time.Hour,
+		maxRetries:    2,
+		retryBackoff:  time.Millisecond,
+		maxBackoff:    time.Millisecond,
+	}, r, w, idx
+}
+
+// eventMessage is the message of a REVIEW_UPDATED event at offset.
+func eventMessage(t *testing.T, offset int64, reviewID uint64) kafka.Message {
+	t.Helper()
+	b, err := proto.Marshal(&eventsv1.ReviewEvent{
+		SchemaVersion:    data.ReviewEventSchemaVersion,
+		EventId:          fmt.Sprint("evt-", offset),
+		Type:             eventsv1.EventType_REVIEW_UPDATED,
+		AggregateId:      reviewID,
+		AggregateVersion: 2,
+		OccurredAt:       timestamppb.Now(),
+		Review:           &eventsv1.ReviewSnapshot{Id: reviewID, Content: "ok", Rating: 5, Version: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return kafka.Message{Topic: "review", Offset: offset, Key: []byte(fmt.Sprint(reviewID)), Value: b}
+}
+
+func header(m kafka.Message, key string) string {
+	for _, h := range m.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func TestFlush(t *testing.T) {
+	transient := func(call int, ops []data.ESBulkOp) ([]data.ESBulkResult, error) {
+		return allStatus(ops, http.StatusServiceUnavailable), nil
+	}
+	tests := []struct {
+		name       string
+		garbage    bool // the second message cannot be decoded
+		respond    func(call int, ops []data.ESBulkOp) ([]data.ESBulkResult, error)
+		indicesErr error
+		dlqDown    bool
+		wantErr    bool
+		calls      int
+		// side effects in order
+		want     []string
+		attempts string // x-dlq-attempts of the dead letters
+	}{
+		{name: "indexed then committed", calls: 1, want: []string{"commit 0", "commit 1"}},
+		{name: "unparseable message dead-lettered", garbage: true, calls: 1,
+			want: []string{"dlq review/0/1", "commit 0", "commit 1"}, attempts: "1"},
+		{name: "rejected event dead-lettered", calls: 1,
+			respond: func(_ int, ops []data.ESBulkOp) ([]data.ESBulkResult, error) {
+				res := allStatus(ops, http.StatusOK)
+				res[0] = data.ESBulkResult{Status: http.StatusBadRequest, Error: "mapper_parsing_exception"}
+				return res, nil
+			},
+			want: []string{"dlq review/0/0", "commit 0", "commit 1"}, attempts: "1"},
+		{name: "stale event skipped", calls: 1,
+			respond: func(_ int, ops []data.ESBulkOp) ([]data.ESBulkResult, error) {
+				return allStatus(ops, http.StatusConflict), nil
+			},
+			want: []string{"commit 0", "commit 1"}},
+		{name: "transient failure retried", calls: 2,
+			respond: func(call int, ops []data.ESBulkOp) ([]data.ESBulkResult, error) {
+				if call == 1 {
+					return allStatus(ops, http.StatusTooManyRequests), nil
+				}
+				return allStatus(ops, http.StatusOK), nil
+			},
+			want: []string{"commit 0", "commit 1"}},
+		{name: "retries give up after max_retries", respond: transient, calls: 3,
+			want: []string{"dlq review/0/0", "dlq review/0/1", "commit 0", "commit 1"}, attempts: "3"},
+		{name: "transport errors give up after max_retries", calls: 3,
+			respond: func(int, []data.ESBulkOp) ([]data.ESBulkResult, error) { return nil, errors.New("connection refused") },
+			want:    []string{"dlq review/0/0", "dlq review/0/1", "commit 0", "commit 1"}, attempts: "3"},
+		{name: "nothing committed while the dead-letter topic fails", respond: transient, dlqDown: true, calls: 3, wantErr: true},
+		{name: "nothing committed without write indices", indicesErr: errors.New("es down"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := &journal{}
+			c, _, w, idx := newTestConsumer(j)
+			idx.respond, idx.indicesErr, w.fail = tt.respond, tt.indicesErr, tt.dlqDown
+			batch := []kafka.Message{eventMessage(t, 0, 1), eventMessage(t, 1, 2)}
+			if tt.garbage {
+				batch[1].Value = []byte("not a review event")
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			err := c.flush(ctx, batch)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("flush() error = %v, want error %v", err, tt.wantErr)
+			}
+			if idx.calls != tt.calls {
+				t.Errorf("bulk requests = %d, want %d", idx.calls, tt.calls)
+			}
+			if got := j.list(); !slices.Equal(got, tt.want) {
+				t.Errorf("side effects = %q, want %q", got, tt.want)
+			}
+			for _, m := range w.msgs {
+				if got := header(m, headerDLQAttempts); got != tt.attempts {
+					t.Errorf("dead letter attempts = %s, want %s", got, tt.attempts)
+				}
+				if header(m, headerDLQError) == "" {
+					t.Error("dead letter has no error")
+				}
+			}
+		})
+	}
+}
+
+func TestRunRetriesFailedBatch(t *testing.T) {
+	j := &journal{}
+	c, r, _, idx := newTestConsumer(j)
+	idx.indicesDown = 2
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		c.run(ctx)
+		close(done)
+	}()
+	// a full batch is flushed at once and retried until ES is back
+	for offset := range int64(2) {
+		r.msgs <- eventMessage(t, offset, uint64(offset+1))
+	}
+	want := []string{"es down", "es down", "commit 0", "commit 1"}
+	deadline := time.After(5 * time.Second)
+	for len(j.list()) < len(want) {
+		select {
+		case <-deadline:
+			t.Fatalf("side effects = %q", j.list())
+		case <-time.After(time.Millisecond):
+		}
+	}
+	cancel()
+	<-done
+	if got := j.list(); !slices.Equal(got, want) {
+		t.Errorf("side effects = %q, want %q", got, want)
+	}
+}
+
+func TestGroupID(t *testing.T) {
+	if got := groupID(&conf.Bootstrap{}); got != defaultGroupID {
+		t.Errorf("groupID() = %s, want %s", got, defaultGroupID)
+	}
+	if got := groupID(&conf.Bootstrap{Task: &conf.Task{GroupId: "review-task-staging"}}); got != "review-task-staging" {
+		t.Errorf("groupID() = %s, want review-task-staging", got)
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	kafka "github.com/segmentio/kafka-go"
+
+	"review-service/internal/conf"
+)
+
+// Headers added to dead-lettered messages. The value and key stay those of
+// the original message, so redrive can publish it back unchanged.
+const (
+	headerDLQError    = "x-dlq-error"
+	headerDLQAttempts = "x-dlq-attempts"
+	headerDLQSource   = "x-dlq-source" // topic/partition/offset of the original
+	headerDLQFailedAt = "x-dlq-failed-at"
+	headerDLQPrefix   = "x-dlq-"
+)
+
+// redriveIdleTimeout ends a redrive once the dead-letter topic is drained.
+const redriveIdleTimeout = 10 * time.Second
+
+// deadLetter describes why an event is given up on.
+type deadLetter struct {
+	Err      string
+	Attempts int
+}
+
+// message returns m wrapped for the dead-letter topic.
+func (d *deadLetter) message(m kafka.Message) kafka.Message {
+	headers := append(withoutDLQHeaders(m.Headers),
+		kafka.Header{Key: headerDLQError, Value: []byte(d.Err)},
+		kafka.Header{Key: headerDLQAttempts, Value: []byte(strconv.Itoa(d.Attempts))},
+		kafka.Header{Key: headerDLQSource, Value: []byte(fmt.Sprintf("%s/%d/%d", m.Topic, m.Partition, m.Offset))},
+		kafka.Header{Key: headerDLQFailedAt, Value: []byte(time.Now().UTC().Format(time.RFC3339))},
+	)
+	return kafka.Message{Key: m.Key, Value: m.Value, Headers: headers}
+}
+
+func withoutDLQHeaders(in []kafka.Header) []kafka.Header {
+	out := make([]kafka.Header, 0, len(in))
+	for _, h := range in {
+		if !strings.HasPrefix(h.Key, headerDLQPrefix) {
+			out = append(out, h)
+		}
+	}
+	return out
+}
+
+func dlqTopic(bc *conf.Bootstrap) string {
+	if t := bc.Task.GetDlqTopic(); t != "" {
+		return t
+	}
+	return bc.Data.Kafka.Topic + ".dlq"
+}
+
+// newDeadLetterWriter returns the writer of the dead-letter topic.
+func newDeadLetterWriter(bc *conf.Bootstrap) *kafka.Writer {
+	return &kafka.Writer{
+		Addr:                   kafka.TCP(bc.Data.Kafka.Brokers...),
+		Topic:                  dlqTopic(bc),
+		Balancer:               &kafka.Hash{},
+		RequiredAcks:           kafka.RequireAll,
+		AllowAutoTopicCreation: true,
+	}
+}
+
+// redrive publishes every message waiting in the dead-letter topic back to
+// the review topic, and returns once none has arrived for redriveIdleTimeout
+// or ctx is done. A message is committed only after it was republished.
+func redrive(ctx context.Context, bc *conf.Bootstrap) error {
+	src := dlqTopic(bc)
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: bc.Data.Kafka.Brokers,
+		// per deployment, like the consumer group of the review topic
+		GroupID: groupID(bc) + "-redrive",
+		Topic:   src,
+	})
+	defer r.Close()
+	w := &kafka.Writer{
+		Addr:         kafka.TCP(bc.Data.Kafka.Brokers...),
+		Topic:        bc.Data.Kafka.Topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+	}
+	defer w.Close()
+
+	log.Printf("redrive: %s -> %s", src, bc.Data.Kafka.Topic)
+	n := 0
+	for {
+		fctx, cancel := context.WithTimeout(ctx, redriveIdleTimeout)
+		m, err := r.FetchMessage(fctx)
+		cancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if errors.Is(err, context.DeadlineExceeded) {
+				log.Printf("redrive: done, %d messages republished", n)
+				return nil
+			}
+			return fmt.Errorf("fetch from %s: %w", src, err)
+		}
+		out := kafka.Message{Key: m.Key, Value: m.Value, Headers: withoutDLQHeaders(m.Headers)}
+		if err := w.WriteMessages(ctx, out); err != nil {
+			return fmt.Errorf("republish %s/%d/%d: %w", m.Topic, m.Partition, m.Offset, err)
+		}
+		if err := r.CommitMessages(ctx, m); err != nil {
+			return fmt.Errorf("commit %s/%d/%d: %w", m.Topic, m.Partition, m.Offset, err)
+		}
+		n++
+	}
+}
@@ -1,9 +1,7 @@
 package main
 
 import (
-    "bytes"
     "context"
-    "encoding/json"
     "flag"
     "fmt"
     "log"
@@ -13,31 +11,32 @@ import (
     "time"
 
     esv8 "github.com/elastic/go-elasticsearch/v8"
-    kafka "github.com/segmentio/kafka-go"
+    "go.opentelemetry.io/otel"
 
     conf "review-service/internal/conf"
+    "review-service/internal/data"
+    "review-service/internal/telemetry"
+    klog "github.com/go-kratos/kratos/v2/log"
     kconfig "github.com/go-kratos/kratos/v2/config"
     kfile "github.com/go-kratos/kratos/v2/config/file"
 )
 
-type reviewEvent struct {
-    Op      string        `json:"op"`
-    Payload *reviewRecord `json:"payload"`
-    Ts      int64         `json:"ts"`
-}
-
-type reviewRecord struct {
-    ID      uint64 `json:"id"`
-    UserID  uint64 `json:"user_id"`
-    Subject string `json:"subject"`
-    Content string `json:"content"`
-    Rating  int32  `json:"rating"`
-}
-
 func main() {
     var confPath string
+    var reindexBatch int
+    var deleteOld bool
     flag.StringVar(&confPath, "conf", "./configs", "config path, file or directory")
+    flag.IntVar(&reindexBatch, "batch", 500, "reindex: rows per bulk request")
+    flag.BoolVar(&deleteOld, "delete-old", false, "reindex: delete the previous indices after the alias swap")
+    flag.Usage = func() {
+        fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] [consume|template|reindex|redrive]\n", os.Args[0])
+        flag.PrintDefaults()
+    }
     flag.Parse()
+    mode := flag.Arg(0)
+    if mode == "" {
+        mode = "consume"
+    }
 
     // Load config via kratos config
     c := kconfig.New(kconfig.WithSource(kfile.NewSource(confPath)))
@@ -50,77 +49,65 @@ func main() {
         log.Fatalf("config scan: %v", err)
     }
 
-    // Setup Elasticsearch client
-    es, err := esv8.NewClient(esv8.Config{Addresses: bc.Data.Elasticsearch.Addresses})
+    // Setup tracing; consumed events continue the trace of the request
+    // that produced them
+    hostname, _ := os.Hostname()
+    shutdownTracing, err := telemetry.Setup(context.Background(), bc.Trace, "review-task", "", hostname)
     if err != nil {
-        log.Fatalf("new es client: %v", err)
-    }
-    indexName := bc.Data.Elasticsearch.Index
-    if indexName == "" {
-        indexName = "reviews"
+        log.Fatalf("setup tracing: %v", err)
     }
+    defer func() {
+        ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+        defer cancel()
+        _ = shutdownTracing(ctx)
+    }()
 
-    // Setup Kafka reader
-    r := kafka.NewReader(kafka.ReaderConfig{
-        Brokers:  bc.Data.Kafka.Brokers,
-        GroupID:  "review-task",
-        Topic:    bc.Data.Kafka.Topic,
-        MinBytes: 10e3, // 10KB
-        MaxBytes: 10e6, // 10MB
+    // Setup Elasticsearch client
+    es, err := esv8.NewClient(esv8.Config{
+        Addresses:       bc.Data.Elasticsearch.Addresses,
+        Username:        bc.Data.Elasticsearch.Username,
+        Password:        bc.Data.Elasticsearch.Password,
+        Instrumentation: esv8.NewOpenTelemetryInstrumentation(otel.GetTracerProvider(), false),
     })
-    defer r.Close()
+    if err != nil {
+        log.Fatalf("new es client: %v", err)
+    }
 
     ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
     defer stop()
 
-    log.Printf("review-task started: topic=%s, es_index=%s", bc.Data.Kafka.Topic, indexName)
-    for {
-        m, err := r.ReadMessage(ctx)
-        if err != nil {
-            if ctx.Err() != nil {
-                log.Printf("context done: %v", ctx.Err())
-                return
-            }
-            log.Printf("read message error: %v", err)
-            time.Sleep(time.Second)
-            continue
+    // The index template must exist before the first document is written,
+    // otherwise ES creates the index with dynamic mapping.
+    indexes := data.NewESIndexManager(es, bc.Data.Elasticsearch, klog.NewStdLogger(os.Stdout))
+    switch mode {
+    case "template":
+        if err := indexes.Apply(ctx); err != nil {
+            log.Fatalf("apply index template: %v", err)
         }
-        var evt reviewEvent
-        if err := json.Unmarshal(m.Value, &evt); err != nil {
-            log.Printf("unmarshal event: %v", err)
-            continue
+        return
+    case "reindex":
+        if err := reindex(ctx, bc.Data, indexes, reindexBatch, deleteOld); err != nil {
+            log.Fatalf("reindex: %v", err)
         }
-        if evt.Payload == nil {
-            continue
+        return
+    case "redrive":
+        if err := redrive(ctx, &bc); err != nil {
+            log.Fatalf("redrive: %v", err)
         }
-        // index or delete
-        switch evt.Op {
-        case "create", "update":
-            body, _ := json.Marshal(map[string]any{
-                "id":       evt.Payload.ID,
-                "user_id":  evt.Payload.UserID,
-                "subject":  evt.Payload.Subject,
-                "content":  evt.Payload.Content,
-                "rating":   evt.Payload.Rating,
-                "ts":       evt.Ts,
-            })
-            res, err := es.Index(indexName, bytesReader(body), es.Index.WithDocumentID(idStr(evt.Payload.ID)))
-            if err != nil {
-                log.Printf("es index error: %v", err)
-                continue
-            }
-            res.Body.Close()
-        case "delete":
-            res, err := es.Delete(indexName, idStr(evt.Payload.ID))
-            if err != nil {
-                log.Printf("es delete error: %v", err)
-                continue
-            }
-            res.Body.Close()
+        return
+    case "consume":
+        if err := indexes.Apply(ctx); err != nil {
+            log.Fatalf("apply index template: %v", err)
         }
+    default:
+        flag.Usage()
+        os.Exit(2)
     }
-}
 
-func idStr(id uint64) string { return fmt.Sprintf("%d", id) }
+    cs := newConsumer(&bc, es, indexes)
+    defer cs.Close()
+    serveMetrics(ctx, bc.GetTask().GetMetricsAddr())
 
-func bytesReader(b []byte) *bytes.Reader { return bytes.NewReader(b) }
\ No newline at end of file
+    log.Printf("review-task started: topic=%s, es_alias=%s", bc.Data.Kafka.Topic, indexes.WriteAlias())
+    cs.run(ctx)
+}
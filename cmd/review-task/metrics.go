@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	kafka "github.com/segmentio/kafka-go"
+)
+
+var (
+	eventsConsumed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "review_task_events_consumed_total",
+		Help: "Review events fetched from Kafka.",
+	})
+	eventsIndexed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "review_task_events_indexed_total",
+		Help: "Review events committed after being applied to Elasticsearch, or skipped as stale or of an unknown type.",
+	})
+	eventsFailed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "review_task_events_failed_total",
+		Help: "Review events sent to the dead-letter topic.",
+	})
+	consumerLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "review_task_consumer_lag",
+		Help: "Messages between the last fetched offset and the high watermark, per partition.",
+	}, []string{"partition"})
+)
+
+// observeFetch records a message handed out by the reader.
+func observeFetch(m kafka.Message) {
+	eventsConsumed.Inc()
+	consumerLag.WithLabelValues(strconv.Itoa(m.Partition)).Set(float64(m.HighWaterMark - m.Offset - 1))
+}
+
+// serveMetrics exposes the Prometheus metrics at addr/metrics until ctx is
+// done. An empty addr disables it.
+func serveMetrics(ctx context.Context, addr string) {
+	if addr == "" {
+		return
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	srv := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("metrics server: %v", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		sctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_ = srv.Shutdown(sctx)
+	}()
+}
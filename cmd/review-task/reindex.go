@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"review-service/internal/conf"
+	"review-service/internal/data"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+// reindex rebuilds the search index from MySQL and swaps the aliases to it.
+func reindex(ctx context.Context, c *conf.Data, indexes *data.ESIndexManager, batch int, deleteOld bool) error {
+	db, err := sql.Open(c.Database.Driver, c.Database.Source)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if err := db.PingContext(ctx); err != nil {
+		return err
+	}
+	res, err := indexes.Reindex(ctx, db, batch, deleteOld)
+	if err != nil {
+		return err
+	}
+	log.Printf("reindex done: index=%s indexed=%d already_present=%d previous=%v", res.Index, res.Indexed, res.Existing, res.Old)
+	return nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	kafka "github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"review-service/internal/telemetry"
+)
+
+var tracer = otel.Tracer("review-service/cmd/review-task")
+
+// startProcessSpans starts one consumer span per message, continuing the
+// trace the outbox relay injected into its headers, so each event's trace
+// runs from the API request to its indexing.
+func startProcessSpans(ctx context.Context, batch []kafka.Message) []trace.Span {
+	spans := make([]trace.Span, len(batch))
+	for i := range batch {
+		m := &batch[i]
+		mctx := otel.GetTextMapPropagator().Extract(ctx, telemetry.HeaderCarrier{Headers: &m.Headers})
+		_, spans[i] = tracer.Start(mctx, m.Topic+" process",
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(
+				semconv.MessagingSystemKafka,
+				semconv.MessagingDestinationName(m.Topic),
+				semconv.MessagingDestinationPartitionID(fmt.Sprint(m.Partition)),
+				semconv.MessagingKafkaMessageOffset(int(m.Offset)),
+				semconv.MessagingKafkaMessageKey(string(m.Key)),
+			),
+		)
+	}
+	return spans
+}
+
+// spanLinks links the batch span to the span of every message in it.
+func spanLinks(spans []trace.Span) []trace.Link {
+	links := make([]trace.Link, 0, len(spans))
+	for _, s := range spans {
+		if sc := s.SpanContext(); sc.IsValid() {
+			links = append(links, trace.Link{SpanContext: sc})
+		}
+	}
+	return links
+}
+
+// endProcessSpans ends the message spans of a flush: dead-lettered messages
+// carry their error, and every message fails if the flush did.
+func endProcessSpans(spans []trace.Span, dead map[int]*deadLetter, err error) {
+	for i, s := range spans {
+		switch d := dead[i]; {
+		case err != nil:
+			s.SetStatus(codes.Error, err.Error())
+		case d != nil:
+			s.SetAttributes(attribute.Bool("review.dead_lettered", true))
+			s.SetStatus(codes.Error, d.Err)
+		}
+		s.End()
+	}
+}
+
+func endSpan(s trace.Span, err error) {
+	if err != nil {
+		s.RecordError(err)
+		s.SetStatus(codes.Error, err.Error())
+	}
+	s.End()
+}
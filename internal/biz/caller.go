@@ -0,0 +1,53 @@
+package biz
+
+import (
+	"context"
+
+	v1 "review-service/api/review/v1"
+)
+
+// Caller roles.
+const (
+	RoleCustomer = "customer"
+	RoleMerchant = "merchant"
+	RoleOperator = "operator"
+)
+
+// Caller is the identity a request is made on behalf of. Requests without one
+// are anonymous and only see public data.
+type Caller struct {
+	UserID uint64
+	Role   string
+}
+
+// ID returns the caller's user id, 0 if anonymous.
+func (c *Caller) ID() uint64 {
+	if c == nil {
+		return 0
+	}
+	return c.UserID
+}
+
+// IsOperator reports whether c may see and moderate every review.
+func (c *Caller) IsOperator() bool { return c != nil && c.Role == RoleOperator }
+
+// ErrPermissionDenied is returned when the caller may not perform an operation.
+var ErrPermissionDenied = v1.ErrorPermissionDenied("permission denied")
+
+// ErrCallerRequired is returned when a write names no user, merchant or
+// operator. With authentication on the id comes from the token, so this only
+// happens when the server runs without it and the request leaves it out.
+var ErrCallerRequired = v1.ErrorCallerRequired("user_id, merchant_id or operator_id is required")
+
+type callerKey struct{}
+
+// NewCallerContext returns a context carrying c.
+func NewCallerContext(ctx context.Context, c *Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, c)
+}
+
+// CallerFromContext returns the caller of the request, nil if anonymous.
+func CallerFromContext(ctx context.Context) *Caller {
+	c, _ := ctx.Value(callerKey{}).(*Caller)
+	return c
+}
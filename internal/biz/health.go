@@ -0,0 +1,68 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Dependency names.
+const (
+	DependencyMySQL         = "mysql"
+	DependencyRedis         = "redis"
+	DependencyElasticsearch = "elasticsearch"
+	DependencyKafka         = "kafka"
+)
+
+// HealthRepo checks the external dependencies of the service.
+type HealthRepo interface {
+	// Dependencies lists the configured dependencies; unconfigured ones, such
+	// as Elasticsearch with the mysql search backend, are left out.
+	Dependencies() []string
+	// Ping returns nil when the dependency is usable.
+	Ping(ctx context.Context, dependency string) error
+}
+
+// DependencyHealth is the result of checking one dependency.
+type DependencyHealth struct {
+	Name    string
+	Err     error
+	Latency time.Duration
+}
+
+// HealthUsecase checks the dependencies of the service.
+type HealthUsecase struct {
+	repo HealthRepo
+	log  *log.Helper
+}
+
+// NewHealthUsecase new a Health usecase.
+func NewHealthUsecase(repo HealthRepo, logger log.Logger) *HealthUsecase {
+	return &HealthUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// Check pings every dependency concurrently, each until ctx is done, and
+// returns the results in the order of HealthRepo.Dependencies.
+func (uc *HealthUsecase) Check(ctx context.Context) []*DependencyHealth {
+	deps := uc.repo.Dependencies()
+	out := make([]*DependencyHealth, len(deps))
+	var wg sync.WaitGroup
+	for i, name := range deps {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			start := time.Now()
+			err := uc.repo.Ping(ctx, name)
+			out[i] = &DependencyHealth{Name: name, Err: err, Latency: time.Since(start)}
+		}(i, name)
+	}
+	wg.Wait()
+	for _, d := range out {
+		if d.Err != nil {
+			uc.log.WithContext(ctx).Warnf("health check %s: %v", d.Name, d.Err)
+		}
+	}
+	return out
+}
@@ -3,14 +3,34 @@ package biz
 import (
     "context"
 
-    "github.com/go-kratos/kratos/v2/errors"
+    v1 "review-service/api/review/v1"
+
     "github.com/go-kratos/kratos/v2/log"
 )
 
+// Review errors; their reasons are the ErrorReason values of the review API.
 var (
-    ErrReviewNotFound = errors.NotFound("REVIEW_NOT_FOUND", "review not found")
+    ErrReviewNotFound = v1.ErrorReviewNotFound("review not found")
+    // ErrReplyNotAllowed is returned when replying to a review that is not APPROVED.
+    ErrReplyNotAllowed = v1.ErrorReplyNotAllowed("only approved reviews can be replied to")
+    // ErrInternal hides storage failures from callers; the cause is logged by the repo.
+    ErrInternal = v1.ErrorInternal("internal error")
+    // ErrInvalidUpdateMask is returned for an update mask naming a field that cannot be updated.
+    ErrInvalidUpdateMask = v1.ErrorInvalidUpdateMask("update_mask may only name subject, content and rating")
+    ErrInvalidRating     = v1.ErrorInvalidRating("rating must be between 1 and 5")
+    ErrEmptyContent      = v1.ErrorEmptyContent("content must not be empty")
+)
+
+// Review fields an update may change, as named in update masks.
+const (
+    FieldSubject = "subject"
+    FieldContent = "content"
+    FieldRating  = "rating"
 )
 
+// updatableFields are the fields an update without a mask sets.
+var updatableFields = []string{FieldSubject, FieldContent, FieldRating}
+
 type Review struct {
     ID      uint64
     UserID  uint64
@@ -18,27 +38,57 @@ type Review struct {
     Content string
     Rating  int32
     Status  string
+    // latest audit decision; zero until the review has been audited
+    AuditReason string
+    AuditBy     uint64
+    AuditAt     int64
+    // last lifecycle transition, see StatusChange
+    StatusChangedBy uint64
+    StatusChangedAt int64
+    // merchant replies; LastReplyAt is zero without replies
+    ReplyCount  int32
+    LastReplyAt int64
+    // incremented by every change of the review
+    Version uint64
+    // set while soft-deleted; deleted reviews are hidden until restored or purged
+    DeletedAt int64
+    DeletedBy uint64
+    // unix seconds
+    CreatedAt int64
+    UpdatedAt int64
 }
 
 type ReviewRepo interface {
     Create(context.Context, *Review) (uint64, error)
-    Update(context.Context, *Review) error
-    Delete(context.Context, uint64) error
+    // Update writes only the named fields of the review (see FieldSubject).
+    Update(ctx context.Context, in *Review, fields []string, ch *StatusChange) error
+    Delete(context.Context, *StatusChange) error
+    Restore(ctx context.Context, id, by uint64) error
     Get(context.Context, uint64) (*Review, error)
-    List(context.Context, *ReviewQuery) ([]*Review, int64, error)
-    Audit(context.Context, uint64, string, string, uint64) error
+    Audit(context.Context, *StatusChange) error
+    Appeal(context.Context, *StatusChange) error
     AddReply(context.Context, *ReviewReply) error
     ListReplies(context.Context, uint64) ([]*ReviewReply, error)
     ListPending(context.Context, int32, int32) ([]*Review, int64, error)
+    // ListAuditHistory fails with ErrReviewNotFound only if the review does
+    // not exist at all; soft-deleted reviews have a history.
+    ListAuditHistory(context.Context, uint64) ([]*AuditRecord, error)
+}
+
+// ReviewSearcher finds reviews matching a ReviewQuery. Implementations live in
+// internal/data (Elasticsearch, MySQL, embedded bleve) and are picked by config.
+type ReviewSearcher interface {
+    Search(context.Context, *ReviewQuery) ([]*Review, int64, error)
 }
 
 type ReviewUsecase struct {
-    repo ReviewRepo
-    log  *log.Helper
+    repo     ReviewRepo
+    searcher ReviewSearcher
+    log      *log.Helper
 }
 
-func NewReviewUsecase(repo ReviewRepo, logger log.Logger) *ReviewUsecase {
-    return &ReviewUsecase{repo: repo, log: log.NewHelper(logger)}
+func NewReviewUsecase(repo ReviewRepo, searcher ReviewSearcher, logger log.Logger) *ReviewUsecase {
+    return &ReviewUsecase{repo: repo, searcher: searcher, log: log.NewHelper(logger)}
 }
 
 func (uc *ReviewUsecase) CreateDemo(ctx context.Context) (uint64, error) {
@@ -46,23 +96,141 @@ func (uc *ReviewUsecase) CreateDemo(ctx context.Context) (uint64, error) {
     return uc.repo.Create(ctx, &Review{UserID: 1, Subject: "Demo", Content: "First review", Rating: 5})
 }
 
+// Get returns a review visible to the caller, following the rules of List:
+// operators see every review, customers also their own in any status, and
+// everyone else only APPROVED ones. A hidden review is not found.
 func (uc *ReviewUsecase) Get(ctx context.Context, id uint64) (*Review, error) {
-    return uc.repo.Get(ctx, id)
+    rev, err := uc.repo.Get(ctx, id)
+    if err != nil {
+        return nil, err
+    }
+    if !canSee(CallerFromContext(ctx), rev) {
+        return nil, ErrReviewNotFound
+    }
+    return rev, nil
+}
+
+// canSee reports whether caller may read rev. Merchant ids are not user ids,
+// so only customers own reviews.
+func canSee(caller *Caller, rev *Review) bool {
+    switch {
+    case rev.Status == StatusApproved, caller.IsOperator():
+        return true
+    case caller != nil && caller.Role == RoleCustomer && caller.UserID != 0:
+        return caller.UserID == rev.UserID
+    }
+    return false
 }
 
 func (uc *ReviewUsecase) Create(ctx context.Context, in *Review) (uint64, error) {
+    if in.UserID == 0 {
+        return 0, ErrCallerRequired
+    }
     uc.log.WithContext(ctx).Infof("Create review user=%d", in.UserID)
     return uc.repo.Create(ctx, in)
 }
 
-func (uc *ReviewUsecase) Update(ctx context.Context, in *Review) error {
-    uc.log.WithContext(ctx).Infof("Update review id=%d", in.ID)
-    return uc.repo.Update(ctx, in)
+// Update edits the fields of a review named by mask, all of them if mask is
+// empty. Editing sends the review back to PENDING so that the new content is
+// moderated again; an edit that changes nothing is a no-op. A non-zero
+// in.Version must match the stored version. Only the author or an operator
+// may edit.
+func (uc *ReviewUsecase) Update(ctx context.Context, in *Review, mask []string) error {
+    if len(mask) == 0 {
+        mask = updatableFields
+    }
+    // the API allows the zero value of fields that may be left out of the mask
+    for _, f := range mask {
+        switch {
+        case f != FieldSubject && f != FieldContent && f != FieldRating:
+            return ErrInvalidUpdateMask
+        case f == FieldContent && in.Content == "":
+            return ErrEmptyContent
+        case f == FieldRating && (in.Rating < 1 || in.Rating > 5):
+            return ErrInvalidRating
+        }
+    }
+    uc.log.WithContext(ctx).Infof("Update review id=%d fields=%v", in.ID, mask)
+    cur, err := uc.repo.Get(ctx, in.ID)
+    if err != nil {
+        return err
+    }
+    caller := CallerFromContext(ctx)
+    if err := checkOwner(caller, cur); err != nil {
+        return err
+    }
+    if err := checkVersion(cur, in.Version); err != nil {
+        return err
+    }
+    changed := changedFields(cur, in, mask)
+    if len(changed) == 0 {
+        return nil
+    }
+    to, err := NextStatus(cur.Status, ActionEdit)
+    if err != nil {
+        return err
+    }
+    in.Status = to
+    return uc.repo.Update(ctx, in, changed, &StatusChange{ReviewID: in.ID, From: cur.Status, To: to, Action: ActionEdit, By: caller.ID(), Version: in.Version})
+}
+
+// checkVersion fails unless rev is at the expected version; 0 expects any.
+// The repo checks again when it writes, this only fails early.
+func checkVersion(rev *Review, expected uint64) error {
+    if expected != 0 && rev.Version != expected {
+        return ErrVersionConflict
+    }
+    return nil
 }
 
+// changedFields returns the fields in mask whose value differs between cur
+// and in, each once, in updatableFields order.
+func changedFields(cur, in *Review, mask []string) []string {
+    var out []string
+    for _, f := range updatableFields {
+        masked := false
+        for _, m := range mask {
+            if m == f { masked = true; break }
+        }
+        if !masked { continue }
+        switch {
+        case f == FieldSubject && in.Subject != cur.Subject,
+            f == FieldContent && in.Content != cur.Content,
+            f == FieldRating && in.Rating != cur.Rating:
+            out = append(out, f)
+        }
+    }
+    return out
+}
+
+// Delete soft-deletes a review on behalf of its author or an operator, and
+// records who did it in the review's audit history.
 func (uc *ReviewUsecase) Delete(ctx context.Context, id uint64) error {
     uc.log.WithContext(ctx).Infof("Delete review id=%d", id)
-    return uc.repo.Delete(ctx, id)
+    cur, err := uc.repo.Get(ctx, id)
+    if err != nil {
+        return err
+    }
+    caller := CallerFromContext(ctx)
+    if err := checkOwner(caller, cur); err != nil {
+        return err
+    }
+    return uc.repo.Delete(ctx, &StatusChange{ReviewID: id, From: cur.Status, To: cur.Status, Action: ActionDelete, By: caller.ID()})
+}
+
+// Restore brings back a soft-deleted review that has not been purged yet.
+func (uc *ReviewUsecase) Restore(ctx context.Context, id uint64) error {
+    uc.log.WithContext(ctx).Infof("Restore review id=%d", id)
+    return uc.repo.Restore(ctx, id, CallerFromContext(ctx).ID())
+}
+
+// checkOwner lets operators and the author of rev through. Without a caller
+// the server runs without authentication and nothing can be checked.
+func checkOwner(caller *Caller, rev *Review) error {
+    if caller == nil || caller.IsOperator() || caller.UserID == rev.UserID {
+        return nil
+    }
+    return ErrPermissionDenied
 }
 
 // ReviewQuery defines search filters for listing reviews
@@ -73,10 +241,21 @@ type ReviewQuery struct {
     UserID   uint64
     RatingMin int32
     RatingMax int32
+    HasReply *bool  // nil: any; true: replied to by the merchant; false: not yet
     Sort     string // relevance|ts|rating
     Order    string // asc|desc
+    // Status visibility: a review matches if its status is in Statuses, or it
+    // belongs to OwnerID and its status is in OwnerStatuses. No status is
+    // filtered when both are empty.
+    Statuses      []string
+    OwnerID       uint64
+    OwnerStatuses []string
 }
 
+// List searches reviews visible to the caller. Anonymous callers, merchants and
+// customers see APPROVED reviews, customers also their own PENDING ones;
+// operators see every status. Requested Statuses narrow that set, they never
+// widen it. Merchant ids are not user ids, so merchants own no reviews.
 func (uc *ReviewUsecase) List(ctx context.Context, in *ReviewQuery) ([]*Review, int64, error) {
     // defaults
     if in == nil { in = &ReviewQuery{} }
@@ -84,7 +263,38 @@ func (uc *ReviewUsecase) List(ctx context.Context, in *ReviewQuery) ([]*Review,
     if in.PageSize <= 0 || in.PageSize > 100 { in.PageSize = 20 }
     if in.Order == "" { in.Order = "desc" }
     if in.Sort == "" { in.Sort = "relevance" }
-    return uc.repo.List(ctx, in)
+    for _, s := range in.Statuses {
+        if !IsStatus(s) { return nil, 0, ErrUnknownStatus(s) }
+    }
+
+    caller := CallerFromContext(ctx)
+    if !caller.IsOperator() {
+        requested := in.Statuses
+        in.Statuses = narrowStatuses([]string{StatusApproved}, requested)
+        in.OwnerID, in.OwnerStatuses = 0, nil
+        if caller != nil && caller.Role == RoleCustomer && caller.UserID != 0 {
+            in.OwnerID = caller.UserID
+            in.OwnerStatuses = narrowStatuses([]string{StatusPending}, requested)
+        }
+        if len(in.Statuses) == 0 && len(in.OwnerStatuses) == 0 {
+            // every requested status is hidden from this caller
+            return nil, 0, nil
+        }
+    }
+    return uc.searcher.Search(ctx, in)
+}
+
+// narrowStatuses returns the allowed statuses that were requested; all of
+// them when none were.
+func narrowStatuses(allowed, requested []string) []string {
+    if len(requested) == 0 { return allowed }
+    var out []string
+    for _, s := range allowed {
+        for _, r := range requested {
+            if s == r { out = append(out, s); break }
+        }
+    }
+    return out
 }
 
 type ReviewReply struct {
@@ -95,11 +305,65 @@ type ReviewReply struct {
     CreatedAt  int64
 }
 
-func (uc *ReviewUsecase) Audit(ctx context.Context, id uint64, decision string, reason string, operatorID uint64) error {
-    return uc.repo.Audit(ctx, id, decision, reason, operatorID)
+// Audit applies an operator's APPROVE or REJECT decision to a PENDING or
+// APPEALED review. A non-zero expectedVersion must match the stored version.
+func (uc *ReviewUsecase) Audit(ctx context.Context, id uint64, decision string, reason string, operatorID uint64, expectedVersion uint64) error {
+    if operatorID == 0 {
+        return ErrCallerRequired
+    }
+    action, err := decisionAction(decision)
+    if err != nil {
+        return err
+    }
+    cur, err := uc.repo.Get(ctx, id)
+    if err != nil {
+        return err
+    }
+    if err := checkVersion(cur, expectedVersion); err != nil {
+        return err
+    }
+    to, err := NextStatus(cur.Status, action)
+    if err != nil {
+        return err
+    }
+    uc.log.WithContext(ctx).Infof("Audit review id=%d %s -> %s by=%d", id, cur.Status, to, operatorID)
+    return uc.repo.Audit(ctx, &StatusChange{ReviewID: id, From: cur.Status, To: to, Action: action, By: operatorID, Reason: reason, Version: expectedVersion})
+}
+
+// Appeal lets the author contest a REJECTED review, queueing it for another audit.
+// Nobody else may appeal it, operators included.
+func (uc *ReviewUsecase) Appeal(ctx context.Context, id uint64, userID uint64, reason string) error {
+    if userID == 0 {
+        return ErrCallerRequired
+    }
+    cur, err := uc.repo.Get(ctx, id)
+    if err != nil {
+        return err
+    }
+    if cur.UserID != userID {
+        return ErrPermissionDenied
+    }
+    to, err := NextStatus(cur.Status, ActionAppeal)
+    if err != nil {
+        return err
+    }
+    uc.log.WithContext(ctx).Infof("Appeal review id=%d by=%d", id, userID)
+    return uc.repo.Appeal(ctx, &StatusChange{ReviewID: id, From: cur.Status, To: to, Action: ActionAppeal, By: userID, Reason: reason})
 }
 
+// AddReply adds a merchant reply. Only APPROVED reviews, the ones merchants
+// can see, may be replied to.
 func (uc *ReviewUsecase) AddReply(ctx context.Context, in *ReviewReply) error {
+    if in.MerchantID == 0 {
+        return ErrCallerRequired
+    }
+    cur, err := uc.repo.Get(ctx, in.ReviewID)
+    if err != nil {
+        return err
+    }
+    if cur.Status != StatusApproved {
+        return ErrReplyNotAllowed
+    }
     return uc.repo.AddReply(ctx, in)
 }
 
@@ -107,6 +371,25 @@ func (uc *ReviewUsecase) ListReplies(ctx context.Context, reviewID uint64) ([]*R
     return uc.repo.ListReplies(ctx, reviewID)
 }
 
+// AuditRecord is one entry of a review's append-only moderation history.
+type AuditRecord struct {
+    ID         uint64
+    ReviewID   uint64
+    Action     StatusAction
+    FromStatus string
+    ToStatus   string
+    OperatorID uint64
+    Reason     string
+    CreatedAt  int64
+}
+
+// ListAuditHistory returns every status change of a review, oldest first. It
+// includes soft-deleted reviews, so operators can see who deleted one, and
+// why, before restoring it.
+func (uc *ReviewUsecase) ListAuditHistory(ctx context.Context, reviewID uint64) ([]*AuditRecord, error) {
+    return uc.repo.ListAuditHistory(ctx, reviewID)
+}
+
 func (uc *ReviewUsecase) ListPending(ctx context.Context, page, pageSize int32) ([]*Review, int64, error) {
     return uc.repo.ListPending(ctx, page, pageSize)
 }
@@ -0,0 +1,116 @@
+package biz
+
+import (
+	v1 "review-service/api/review/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Review statuses.
+const (
+	StatusPending  = "PENDING"
+	StatusApproved = "APPROVED"
+	StatusRejected = "REJECTED"
+	StatusAppealed = "APPEALED"
+)
+
+// StatusAction is something that may move a review to another status.
+type StatusAction string
+
+const (
+	ActionApprove StatusAction = "approve"
+	ActionReject  StatusAction = "reject"
+	ActionEdit    StatusAction = "edit"
+	ActionAppeal  StatusAction = "appeal"
+	// ActionDelete and ActionRestore are recorded in the audit history only;
+	// they do not change the status.
+	ActionDelete  StatusAction = "delete"
+	ActionRestore StatusAction = "restore"
+)
+
+// statusTransitions is the review lifecycle: current status -> action -> next status.
+// Any pair not listed here is rejected.
+//
+//	PENDING  --approve--> APPROVED --edit--> PENDING
+//	PENDING  --reject---> REJECTED --appeal--> APPEALED --approve/reject--> APPROVED/REJECTED
+//	REJECTED --edit-----> PENDING
+var statusTransitions = map[string]map[StatusAction]string{
+	StatusPending: {
+		ActionApprove: StatusApproved,
+		ActionReject:  StatusRejected,
+		ActionEdit:    StatusPending,
+	},
+	StatusApproved: {
+		ActionEdit: StatusPending,
+	},
+	StatusRejected: {
+		ActionAppeal: StatusAppealed,
+		ActionEdit:   StatusPending,
+	},
+	StatusAppealed: {
+		ActionApprove: StatusApproved,
+		ActionReject:  StatusRejected,
+		ActionEdit:    StatusPending,
+	},
+}
+
+var (
+	// ErrInvalidDecision is returned for audit decisions other than APPROVE or REJECT.
+	ErrInvalidDecision = v1.ErrorInvalidDecision("decision must be APPROVE or REJECT")
+	// ErrStatusConflict is returned when the review status changed while a transition was applied.
+	ErrStatusConflict = v1.ErrorStatusConflict("review status changed concurrently, reload and retry")
+	// ErrVersionConflict is returned when the review is no longer at the version the caller expected.
+	ErrVersionConflict = v1.ErrorVersionConflict("review was modified, reload and retry")
+	// ErrReviewNotDeleted is returned when restoring a review that is not deleted.
+	ErrReviewNotDeleted = v1.ErrorReviewNotDeleted("review is not deleted")
+)
+
+// ErrUnknownStatus reports a status filter that is not a review status.
+func ErrUnknownStatus(status string) *errors.Error {
+	return v1.ErrorUnknownStatus("unknown review status %q", status)
+}
+
+// IsStatus reports whether s is a review status.
+func IsStatus(s string) bool {
+	_, ok := statusTransitions[s]
+	return ok
+}
+
+// ErrInvalidStatusTransition reports that action is not allowed in status from.
+func ErrInvalidStatusTransition(from string, action StatusAction) *errors.Error {
+	return v1.ErrorInvalidStatusTransition("cannot %s a %s review", action, from).
+		WithMetadata(map[string]string{"from": from, "action": string(action)})
+}
+
+// NextStatus returns the status a review in from moves to after action.
+func NextStatus(from string, action StatusAction) (string, error) {
+	if to, ok := statusTransitions[from][action]; ok {
+		return to, nil
+	}
+	return "", ErrInvalidStatusTransition(from, action)
+}
+
+// decisionAction maps an AuditReview decision onto a lifecycle action.
+func decisionAction(decision string) (StatusAction, error) {
+	switch decision {
+	case "APPROVE":
+		return ActionApprove, nil
+	case "REJECT":
+		return ActionReject, nil
+	default:
+		return "", ErrInvalidDecision
+	}
+}
+
+// StatusChange is one applied lifecycle transition. Repos apply it only if the
+// review is still in From, and return ErrStatusConflict otherwise.
+type StatusChange struct {
+	ReviewID uint64
+	From     string
+	To       string
+	Action   StatusAction
+	By       uint64 // user or operator who caused the change
+	Reason   string
+	// Version is the version the caller expects the review to be at; 0 skips the check.
+	Version uint64
+}
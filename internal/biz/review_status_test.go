@@ -0,0 +1,101 @@
+package biz
+
+import (
+	"testing"
+
+	v1 "review-service/api/review/v1"
+)
+
+var (
+	allStatuses = []string{StatusPending, StatusApproved, StatusRejected, StatusAppealed}
+	allActions  = []StatusAction{ActionApprove, ActionReject, ActionEdit, ActionAppeal, ActionDelete, ActionRestore}
+)
+
+func TestNextStatus(t *testing.T) {
+	// the complete lifecycle; every other status and action pair is invalid
+	allowed := map[string]map[StatusAction]string{
+		StatusPending: {
+			ActionApprove: StatusApproved,
+			ActionReject:  StatusRejected,
+			ActionEdit:    StatusPending,
+		},
+		StatusApproved: {
+			ActionEdit: StatusPending,
+		},
+		StatusRejected: {
+			ActionAppeal: StatusAppealed,
+			ActionEdit:   StatusPending,
+		},
+		StatusAppealed: {
+			ActionApprove: StatusApproved,
+			ActionReject:  StatusRejected,
+			ActionEdit:    StatusPending,
+		},
+	}
+	for _, from := range append(allStatuses, "", "DELETED") {
+		for _, action := range append(allActions, "") {
+			want, ok := allowed[from][action]
+			got, err := NextStatus(from, action)
+			if ok {
+				if err != nil || got != want {
+					t.Errorf("NextStatus(%q, %q) = %q, %v; want %q", from, action, got, err, want)
+				}
+				continue
+			}
+			if err == nil {
+				t.Errorf("NextStatus(%q, %q) = %q; want an invalid transition", from, action, got)
+				continue
+			}
+			if !v1.IsInvalidStatusTransition(err) {
+				t.Errorf("NextStatus(%q, %q) error = %v; want INVALID_STATUS_TRANSITION", from, action, err)
+			}
+		}
+	}
+}
+
+func TestStatusTransitionsStayInLifecycle(t *testing.T) {
+	for from, next := range statusTransitions {
+		for action, to := range next {
+			if !IsStatus(to) {
+				t.Errorf("%s --%s--> %q: not a review status", from, action, to)
+			}
+			if action == ActionDelete || action == ActionRestore {
+				t.Errorf("%s --%s-->: delete and restore must not change the status", from, action)
+			}
+		}
+	}
+	for _, st := range allStatuses {
+		if !IsStatus(st) {
+			t.Errorf("IsStatus(%q) = false", st)
+		}
+		if _, err := NextStatus(st, ActionEdit); err != nil {
+			t.Errorf("a %s review cannot be edited: %v", st, err)
+		}
+	}
+}
+
+func TestDecisionAction(t *testing.T) {
+	tests := []struct {
+		decision string
+		want     StatusAction
+		wantErr  bool
+	}{
+		{decision: "APPROVE", want: ActionApprove},
+		{decision: "REJECT", want: ActionReject},
+		{decision: "approve", wantErr: true},
+		{decision: "", wantErr: true},
+		{decision: "APPEAL", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := decisionAction(tt.decision)
+		if tt.wantErr {
+			if !v1.IsInvalidDecision(err) {
+				t.Errorf("decisionAction(%q) error = %v; want INVALID_DECISION", tt.decision, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("decisionAction(%q) = %q, %v; want %q", tt.decision, got, err, tt.want)
+		}
+	}
+}
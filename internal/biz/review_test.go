@@ -0,0 +1,360 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	v1 "review-service/api/review/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeReviewRepo serves reviews from memory and records status changes.
+// Methods the tests do not use panic through the nil embedded interface.
+type fakeReviewRepo struct {
+	ReviewRepo
+	reviews map[uint64]*Review
+	changes []*StatusChange
+	updates []fakeUpdate
+}
+
+// fakeUpdate is one call of fakeReviewRepo.Update.
+type fakeUpdate struct {
+	review *Review
+	fields []string
+}
+
+func (r *fakeReviewRepo) Get(_ context.Context, id uint64) (*Review, error) {
+	rev, ok := r.reviews[id]
+	if !ok {
+		return nil, ErrReviewNotFound
+	}
+	cp := *rev
+	return &cp, nil
+}
+
+func (r *fakeReviewRepo) Appeal(_ context.Context, ch *StatusChange) error {
+	r.changes = append(r.changes, ch)
+	return nil
+}
+
+func (r *fakeReviewRepo) Update(_ context.Context, in *Review, fields []string, ch *StatusChange) error {
+	cp := *in
+	r.updates = append(r.updates, fakeUpdate{review: &cp, fields: fields})
+	r.changes = append(r.changes, ch)
+	return nil
+}
+
+func (r *fakeReviewRepo) Delete(_ context.Context, ch *StatusChange) error {
+	r.changes = append(r.changes, ch)
+	return nil
+}
+
+func TestAppeal(t *testing.T) {
+	const author, other = 7, 8
+	tests := []struct {
+		name    string
+		status  string
+		userID  uint64
+		check   func(error) bool
+		applied bool
+	}{
+		{name: "author appeals a rejected review", status: StatusRejected, userID: author, applied: true},
+		{name: "another customer may not appeal", status: StatusRejected, userID: other, check: v1.IsPermissionDenied},
+		{name: "caller required", status: StatusRejected, userID: 0, check: v1.IsCallerRequired},
+		{name: "only rejected reviews", status: StatusApproved, userID: author, check: v1.IsInvalidStatusTransition},
+		{name: "not twice", status: StatusAppealed, userID: author, check: v1.IsInvalidStatusTransition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeReviewRepo{reviews: map[uint64]*Review{1: {ID: 1, UserID: author, Status: tt.status}}}
+			uc := NewReviewUsecase(repo, nil, log.DefaultLogger)
+			err := uc.Appeal(context.Background(), 1, tt.userID, "please look again")
+			if tt.check != nil && !tt.check(err) {
+				t.Fatalf("Appeal() error = %v", err)
+			}
+			if tt.check == nil && err != nil {
+				t.Fatalf("Appeal() error = %v", err)
+			}
+			if applied := len(repo.changes) > 0; applied != tt.applied {
+				t.Fatalf("status change applied = %v, want %v", applied, tt.applied)
+			}
+			if tt.applied {
+				ch := repo.changes[0]
+				if ch.From != StatusRejected || ch.To != StatusAppealed || ch.Action != ActionAppeal || ch.By != author {
+					t.Errorf("status change = %+v", ch)
+				}
+			}
+		})
+	}
+
+	t.Run("missing review", func(t *testing.T) {
+		uc := NewReviewUsecase(&fakeReviewRepo{}, nil, log.DefaultLogger)
+		if err := uc.Appeal(context.Background(), 1, author, ""); !v1.IsReviewNotFound(err) {
+			t.Fatalf("Appeal() error = %v", err)
+		}
+	})
+}
+
+// fakeSearcher records the query it was given.
+type fakeSearcher struct {
+	query *ReviewQuery
+}
+
+func (s *fakeSearcher) Search(_ context.Context, in *ReviewQuery) ([]*Review, int64, error) {
+	cp := *in
+	s.query = &cp
+	return nil, 0, nil
+}
+
+func TestListVisibility(t *testing.T) {
+	customer := &Caller{UserID: 42, Role: RoleCustomer}
+	merchant := &Caller{UserID: 42, Role: RoleMerchant}
+	operator := &Caller{UserID: 42, Role: RoleOperator}
+	tests := []struct {
+		name          string
+		caller        *Caller
+		requested     []string
+		searched      bool
+		statuses      []string
+		ownerID       uint64
+		ownerStatuses []string
+	}{
+		{name: "anonymous sees approved", searched: true, statuses: []string{StatusApproved}},
+		{name: "customer also sees own pending", caller: customer, searched: true, statuses: []string{StatusApproved}, ownerID: 42, ownerStatuses: []string{StatusPending}},
+		{name: "customer asking for pending sees only own", caller: customer, requested: []string{StatusPending}, searched: true, ownerID: 42, ownerStatuses: []string{StatusPending}},
+		{name: "customer asking for approved", caller: customer, requested: []string{StatusApproved}, searched: true, statuses: []string{StatusApproved}, ownerID: 42},
+		{name: "customer cannot see rejected", caller: customer, requested: []string{StatusRejected}},
+		{name: "merchant sees approved only", caller: merchant, searched: true, statuses: []string{StatusApproved}},
+		{name: "merchant does not own customer reviews", caller: merchant, requested: []string{StatusPending}},
+		{name: "operator sees every status", caller: operator, searched: true},
+		{name: "operator filters freely", caller: operator, requested: []string{StatusRejected, StatusAppealed}, searched: true, statuses: []string{StatusRejected, StatusAppealed}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &fakeSearcher{}
+			uc := NewReviewUsecase(&fakeReviewRepo{}, s, log.DefaultLogger)
+			ctx := context.Background()
+			if tt.caller != nil {
+				ctx = NewCallerContext(ctx, tt.caller)
+			}
+			if _, _, err := uc.List(ctx, &ReviewQuery{Statuses: tt.requested}); err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+			if (s.query != nil) != tt.searched {
+				t.Fatalf("searched = %v, want %v", s.query != nil, tt.searched)
+			}
+			if s.query == nil {
+				return
+			}
+			q := s.query
+			if !slices.Equal(q.Statuses, tt.statuses) || q.OwnerID != tt.ownerID || !slices.Equal(q.OwnerStatuses, tt.ownerStatuses) {
+				t.Errorf("query statuses=%v owner=%d ownerStatuses=%v; want %v, %d, %v",
+					q.Statuses, q.OwnerID, q.OwnerStatuses, tt.statuses, tt.ownerID, tt.ownerStatuses)
+			}
+		})
+	}
+
+	t.Run("unknown status", func(t *testing.T) {
+		uc := NewReviewUsecase(&fakeReviewRepo{}, &fakeSearcher{}, log.DefaultLogger)
+		if _, _, err := uc.List(context.Background(), &ReviewQuery{Statuses: []string{"DELETED"}}); !v1.IsUnknownStatus(err) {
+			t.Fatalf("List() error = %v", err)
+		}
+	})
+}
+
+func TestNarrowStatuses(t *testing.T) {
+	tests := []struct {
+		name               string
+		allowed, requested []string
+		want               []string
+	}{
+		{name: "nothing requested", allowed: []string{StatusApproved}, want: []string{StatusApproved}},
+		{name: "allowed requested", allowed: []string{StatusApproved}, requested: []string{StatusApproved, StatusPending}, want: []string{StatusApproved}},
+		{name: "none allowed", allowed: []string{StatusApproved}, requested: []string{StatusRejected}},
+		{name: "keeps allowed order", allowed: []string{StatusPending, StatusApproved}, requested: []string{StatusApproved, StatusPending}, want: []string{StatusPending, StatusApproved}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := narrowStatuses(tt.allowed, tt.requested); !slices.Equal(got, tt.want) {
+				t.Errorf("narrowStatuses(%v, %v) = %v, want %v", tt.allowed, tt.requested, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetVisibility(t *testing.T) {
+	const author = 42
+	customer := &Caller{UserID: author, Role: RoleCustomer}
+	other := &Caller{UserID: author + 1, Role: RoleCustomer}
+	// merchant ids are not user ids, even when they are equal
+	merchant := &Caller{UserID: author, Role: RoleMerchant}
+	operator := &Caller{UserID: 1, Role: RoleOperator}
+	statuses := []string{StatusPending, StatusApproved, StatusRejected, StatusAppealed}
+	tests := []struct {
+		name    string
+		caller  *Caller
+		visible []string
+	}{
+		{name: "anonymous", visible: []string{StatusApproved}},
+		{name: "author", caller: customer, visible: statuses},
+		{name: "another customer", caller: other, visible: []string{StatusApproved}},
+		{name: "merchant", caller: merchant, visible: []string{StatusApproved}},
+		{name: "operator", caller: operator, visible: statuses},
+	}
+	for _, tt := range tests {
+		for _, status := range statuses {
+			t.Run(tt.name+"/"+status, func(t *testing.T) {
+				repo := &fakeReviewRepo{reviews: map[uint64]*Review{1: {ID: 1, UserID: author, Status: status}}}
+				uc := NewReviewUsecase(repo, nil, log.DefaultLogger)
+				ctx := context.Background()
+				if tt.caller != nil {
+					ctx = NewCallerContext(ctx, tt.caller)
+				}
+				rev, err := uc.Get(ctx, 1)
+				if slices.Contains(tt.visible, status) {
+					if err != nil || rev.ID != 1 {
+						t.Fatalf("Get() = %v, %v; want the review", rev, err)
+					}
+				} else if !v1.IsReviewNotFound(err) {
+					t.Fatalf("Get() error = %v, want REVIEW_NOT_FOUND", err)
+				}
+			})
+		}
+	}
+}
+
+func TestOwnership(t *testing.T) {
+	const author = 7
+	tests := []struct {
+		name    string
+		caller  *Caller
+		allowed bool
+	}{
+		{name: "author", caller: &Caller{UserID: author, Role: RoleCustomer}, allowed: true},
+		{name: "another customer", caller: &Caller{UserID: author + 1, Role: RoleCustomer}},
+		{name: "operator", caller: &Caller{UserID: author + 1, Role: RoleOperator}, allowed: true},
+		// authentication is disabled
+		{name: "no caller", allowed: true},
+	}
+	ops := map[string]func(*ReviewUsecase, context.Context) error{
+		"update": func(uc *ReviewUsecase, ctx context.Context) error {
+			return uc.Update(ctx, &Review{ID: 1, Content: "edited"}, []string{FieldContent})
+		},
+		"delete": func(uc *ReviewUsecase, ctx context.Context) error {
+			return uc.Delete(ctx, 1)
+		},
+	}
+	for _, tt := range tests {
+		for op, call := range ops {
+			t.Run(tt.name+"/"+op, func(t *testing.T) {
+				repo := &fakeReviewRepo{reviews: map[uint64]*Review{1: {ID: 1, UserID: author, Content: "original", Rating: 4, Status: StatusApproved}}}
+				uc := NewReviewUsecase(repo, nil, log.DefaultLogger)
+				ctx := context.Background()
+				if tt.caller != nil {
+					ctx = NewCallerContext(ctx, tt.caller)
+				}
+				err := call(uc, ctx)
+				if !tt.allowed {
+					if !v1.IsPermissionDenied(err) {
+						t.Fatalf("error = %v, want PERMISSION_DENIED", err)
+					}
+					if len(repo.changes) > 0 {
+						t.Fatalf("repo changed: %+v", repo.changes)
+					}
+					return
+				}
+				if err != nil || len(repo.changes) != 1 {
+					t.Fatalf("error = %v, changes = %+v", err, repo.changes)
+				}
+				if want := tt.caller.ID(); repo.changes[0].By != want {
+					t.Errorf("changed by %d, want %d", repo.changes[0].By, want)
+				}
+			})
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	stored := Review{ID: 1, UserID: 7, Subject: "battery", Content: "lasts all day", Rating: 4, Status: StatusApproved, Version: 3}
+	tests := []struct {
+		name  string
+		in    Review
+		mask  []string
+		check func(error) bool
+		// fields written, nil if the update must not reach the repo
+		fields []string
+	}{
+		{name: "unknown mask path", in: Review{Subject: "x"}, mask: []string{"status"}, check: v1.IsInvalidUpdateMask},
+		{name: "unknown path among known ones", in: Review{Subject: "x"}, mask: []string{FieldSubject, "user_id"}, check: v1.IsInvalidUpdateMask},
+		{name: "masked empty content", in: Review{Content: ""}, mask: []string{FieldContent}, check: v1.IsEmptyContent},
+		{name: "masked zero rating", in: Review{Rating: 0}, mask: []string{FieldRating}, check: v1.IsInvalidRating},
+		{name: "masked rating too high", in: Review{Rating: 6}, mask: []string{FieldRating}, check: v1.IsInvalidRating},
+		{name: "no mask checks every field", in: Review{Subject: "battery", Content: "lasts all day"}, check: v1.IsInvalidRating},
+		{name: "zero values outside the mask are fine", in: Review{Subject: "screen"}, mask: []string{FieldSubject}, fields: []string{FieldSubject}},
+		{name: "empty subject may be set", in: Review{Subject: ""}, mask: []string{FieldSubject}, fields: []string{FieldSubject}},
+		{name: "no mask names only what changed", in: Review{Subject: "battery", Content: "lasts two days", Rating: 4}, fields: []string{FieldContent}},
+		{name: "mask order does not matter", in: Review{Subject: "screen", Rating: 5}, mask: []string{FieldRating, FieldSubject}, fields: []string{FieldSubject, FieldRating}},
+		{name: "repeated path named once", in: Review{Content: "lasts two days"}, mask: []string{FieldContent, FieldContent}, fields: []string{FieldContent}},
+		{name: "unchanged masked field", in: Review{Subject: "battery", Content: "ignored"}, mask: []string{FieldSubject}},
+		{name: "no-op edit", in: Review{Subject: "battery", Content: "lasts all day", Rating: 4}},
+		{name: "matching version", in: Review{Rating: 5, Version: 3}, mask: []string{FieldRating}, fields: []string{FieldRating}},
+		{name: "stale version", in: Review{Rating: 5, Version: 2}, mask: []string{FieldRating}, check: v1.IsVersionConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cur := stored
+			repo := &fakeReviewRepo{reviews: map[uint64]*Review{1: &cur}}
+			uc := NewReviewUsecase(repo, nil, log.DefaultLogger)
+			in := tt.in
+			in.ID = 1
+			err := uc.Update(context.Background(), &in, tt.mask)
+			if tt.check != nil {
+				if !tt.check(err) {
+					t.Fatalf("Update() error = %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			if tt.fields == nil {
+				if len(repo.updates) > 0 {
+					t.Fatalf("repo updated %v; want no update, status kept %s", repo.updates[0].fields, stored.Status)
+				}
+				return
+			}
+			if len(repo.updates) != 1 {
+				t.Fatalf("repo updated %d times, want once", len(repo.updates))
+			}
+			up, ch := repo.updates[0], repo.changes[0]
+			if !slices.Equal(up.fields, tt.fields) {
+				t.Errorf("changed fields = %v, want %v", up.fields, tt.fields)
+			}
+			if up.review.Status != StatusPending || ch.From != StatusApproved || ch.To != StatusPending || ch.Action != ActionEdit {
+				t.Errorf("status = %s, change = %+v; want back to PENDING", up.review.Status, ch)
+			}
+		})
+	}
+}
+
+func TestChangedFields(t *testing.T) {
+	cur := &Review{Subject: "a", Content: "b", Rating: 3}
+	tests := []struct {
+		name string
+		in   *Review
+		mask []string
+		want []string
+	}{
+		{name: "nothing changed", in: &Review{Subject: "a", Content: "b", Rating: 3}, mask: updatableFields},
+		{name: "every field changed", in: &Review{Subject: "x", Content: "y", Rating: 5}, mask: updatableFields, want: updatableFields},
+		{name: "changes outside the mask ignored", in: &Review{Subject: "x", Content: "y", Rating: 5}, mask: []string{FieldContent}, want: []string{FieldContent}},
+		{name: "empty mask", in: &Review{Subject: "x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := changedFields(cur, tt.in, tt.mask); !slices.Equal(got, tt.want) {
+				t.Errorf("changedFields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
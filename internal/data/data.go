@@ -7,16 +7,20 @@ import (
 
     "review-service/internal/conf"
 
+    "github.com/XSAM/otelsql"
     "github.com/go-kratos/kratos/v2/log"
     "github.com/google/wire"
     _ "github.com/go-sql-driver/mysql"
     redis "github.com/redis/go-redis/v9"
     kafka "github.com/segmentio/kafka-go"
     esv8 "github.com/elastic/go-elasticsearch/v8"
+    "github.com/blevesearch/bleve/v2"
+    "go.opentelemetry.io/otel"
+    semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewGreeterRepo, NewReviewRepo)
+var ProviderSet = wire.NewSet(NewData, NewGreeterRepo, NewReviewRepo, NewReviewSearcher, NewOutboxRelay, NewReviewPurger, NewHealthRepo)
 
 // Data holds shared clients.
 type Data struct {
@@ -25,14 +29,19 @@ type Data struct {
     Kafka *kafka.Writer
     ES *esv8.Client
     ESIndex string
+    // Bleve is the embedded search index, opened only for the bleve search backend.
+    Bleve bleve.Index
 }
 
 // NewData initializes database and redis clients from configuration.
 func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
     helper := log.NewHelper(logger)
 
-    // Setup SQL DB (MySQL)
-    db, err := sql.Open(c.Database.Driver, c.Database.Source)
+    // Setup SQL DB (MySQL), traced within traced requests
+    db, err := otelsql.Open(c.Database.Driver, c.Database.Source,
+        otelsql.WithAttributes(semconv.DBSystemMySQL),
+        otelsql.WithSpanOptions(sqlSpanOptions),
+    )
     if err != nil {
         return nil, nil, err
     }
@@ -53,19 +62,24 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
             ReadTimeout:  durationOrZero(c.Redis.ReadTimeout),
             WriteTimeout: durationOrZero(c.Redis.WriteTimeout),
         })
+        rdb.AddHook(newRedisTracing())
         // simple ping
         if err := rdb.Ping(context.Background()).Err(); err != nil {
             helper.Warnf("redis ping failed: %v", err)
         }
     }
 
-    // Setup Kafka writer
+    // Setup Kafka writer; events are keyed by review id, so hashing keeps
+    // each review's events on one partition, in order
     var kw *kafka.Writer
     if c.Kafka != nil && len(c.Kafka.Brokers) > 0 && c.Kafka.Topic != "" {
+        // the relay marks rows sent once written, so wait for every
+        // in-sync replica to acknowledge them
         kw = &kafka.Writer{
-            Addr:     kafka.TCP(c.Kafka.Brokers...),
-            Topic:    c.Kafka.Topic,
-            Balancer: &kafka.LeastBytes{},
+            Addr:         kafka.TCP(c.Kafka.Brokers...),
+            Topic:        c.Kafka.Topic,
+            Balancer:     &kafka.Hash{},
+            RequiredAcks: kafka.RequireAll,
         }
     }
 
@@ -79,16 +93,33 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
             Addresses: c.Elasticsearch.Addresses,
             Username:  c.Elasticsearch.Username,
             Password:  c.Elasticsearch.Password,
+            Instrumentation: esv8.NewOpenTelemetryInstrumentation(otel.GetTracerProvider(), false),
         })
         if err != nil {
             helper.Warnf("es client init failed: %v", err)
         } else {
+            // the template and the first index are installed by
+            // `review-task template` (or review-task at startup), not by
+            // every replica of the service
             es = esClient
         }
     }
 
+    // Setup embedded search index
+    var bi bleve.Index
+    if searchBackend(c) == SearchBleve {
+        path := ""
+        if c.Search != nil { path = c.Search.BlevePath }
+        bi, err = openBleve(context.Background(), path, db, helper)
+        if err != nil {
+            _ = db.Close()
+            return nil, nil, err
+        }
+    }
+
     cleanup := func() {
         helper.Info("closing the data resources")
+        if bi != nil { _ = bi.Close() }
         if rdb != nil {
             _ = rdb.Close()
         }
@@ -97,7 +128,21 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
         }
         if kw != nil { _ = kw.Close() }
     }
-    return &Data{DB: db, RDB: rdb, Kafka: kw, ES: es, ESIndex: esIndex}, cleanup, nil
+    return &Data{DB: db, RDB: rdb, Kafka: kw, ES: es, ESIndex: esIndex, Bleve: bi}, cleanup, nil
+}
+
+// InTx runs fn in a database transaction, committing if fn returns nil
+// and rolling back otherwise.
+func (d *Data) InTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+    tx, err := d.DB.BeginTx(ctx, nil)
+    if err != nil {
+        return err
+    }
+    if err := fn(tx); err != nil {
+        _ = tx.Rollback()
+        return err
+    }
+    return tx.Commit()
 }
 
 func durationOrZero(dur interface{ AsDuration() time.Duration }) time.Duration {
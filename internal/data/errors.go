@@ -0,0 +1,37 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"review-service/internal/biz"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// storageError maps a failure returned by MySQL, Redis, Elasticsearch or
+// bleve onto the review API's error reasons. Errors that already carry a
+// reason pass through; anything else is logged and becomes INTERNAL, so
+// driver messages and SQL never reach callers.
+func storageError(ctx context.Context, l *log.Helper, err error) error {
+	if err == nil {
+		return nil
+	}
+	if ke := new(kerrors.Error); errors.As(err, &ke) {
+		return err
+	}
+	l.WithContext(ctx).Errorf("storage: %v", err)
+	return biz.ErrInternal.WithCause(err)
+}
+
+// storageErrorSearcher applies storageError to the errors of a searcher.
+type storageErrorSearcher struct {
+	biz.ReviewSearcher
+	log *log.Helper
+}
+
+func (s *storageErrorSearcher) Search(ctx context.Context, in *biz.ReviewQuery) ([]*biz.Review, int64, error) {
+	list, total, err := s.ReviewSearcher.Search(ctx, in)
+	return list, total, storageError(ctx, s.log, err)
+}
@@ -0,0 +1,40 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestStorageError(t *testing.T) {
+	l := log.NewHelper(log.DefaultLogger)
+	tests := []struct {
+		name  string
+		err   error
+		check func(error) bool
+	}{
+		{name: "nil", err: nil, check: func(err error) bool { return err == nil }},
+		{name: "reason kept", err: biz.ErrReviewNotFound, check: v1.IsReviewNotFound},
+		{name: "wrapped reason kept", err: errors.Join(errors.New("load"), biz.ErrVersionConflict), check: v1.IsVersionConflict},
+		// no table has a unique key a review write could violate
+		{name: "duplicate key is internal", err: &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}, check: v1.IsInternal},
+		{name: "driver error hidden", err: errors.New("dial tcp 10.0.0.1:3306: connection refused"), check: v1.IsInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := storageError(context.Background(), l, tt.err)
+			if !tt.check(got) {
+				t.Fatalf("storageError(%v) = %v", tt.err, got)
+			}
+			if v1.IsInternal(got) && got.Error() == tt.err.Error() {
+				t.Errorf("driver message leaked: %v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	esv8 "github.com/elastic/go-elasticsearch/v8"
+)
+
+// ESBulkOp is one action of a _bulk request.
+type ESBulkOp struct {
+	Action string // index|create|update|delete
+	Index  string
+	ID     string
+	Doc    any // document for index/create, partial document for update
+	// Version, if set, applies the op with external versioning: ES rejects
+	// it with 409 unless Version is higher than the stored document's.
+	Version uint64
+}
+
+// ESBulkResult is the per-item outcome of a _bulk request, in request order.
+type ESBulkResult struct {
+	Status int
+	Error  string
+}
+
+// Failed reports whether the item was rejected.
+func (r ESBulkResult) Failed() bool { return r.Status >= 300 }
+
+// ESBulk sends ops in one _bulk request. The error covers transport and
+// request-level failures; item failures are reported in the results.
+func ESBulk(ctx context.Context, es *esv8.Client, ops []ESBulkOp) ([]ESBulkResult, error) {
+	if len(ops) == 0 {
+		return nil, nil
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, op := range ops {
+		meta := map[string]any{"_index": op.Index, "_id": op.ID}
+		if op.Version > 0 {
+			meta["version"], meta["version_type"] = op.Version, "external"
+		}
+		if err := enc.Encode(map[string]any{op.Action: meta}); err != nil {
+			return nil, err
+		}
+		switch op.Action {
+		case "delete":
+		case "update":
+			if err := enc.Encode(map[string]any{"doc": op.Doc}); err != nil {
+				return nil, err
+			}
+		default:
+			if err := enc.Encode(op.Doc); err != nil {
+				return nil, err
+			}
+		}
+	}
+	res, err := es.Bulk(bytes.NewReader(buf.Bytes()), es.Bulk.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return nil, fmt.Errorf("es bulk: %s", res.String())
+	}
+	var parsed struct {
+		Items []map[string]struct {
+			Status int             `json:"status"`
+			Error  json.RawMessage `json:"error"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return nil, err
+	}
+	if len(parsed.Items) != len(ops) {
+		return nil, fmt.Errorf("es bulk: %d results for %d ops", len(parsed.Items), len(ops))
+	}
+	out := make([]ESBulkResult, len(ops))
+	for i, item := range parsed.Items {
+		for _, v := range item {
+			out[i] = ESBulkResult{Status: v.Status}
+			if len(v.Error) > 0 {
+				out[i].Error = string(v.Error)
+			}
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,38 @@
+package data
+
+import "review-service/internal/biz"
+
+// ReviewDocument is the Elasticsearch document of a review; it must match the
+// mapping in ESIndexManager.Template. ts is the time of the change that
+// produced it; searches sort by created_at, not ts.
+//
+// Every write replaces the whole document, with the review version as the
+// external ES version: partial updates cannot be externally versioned, and
+// each event carries the full review anyway.
+func ReviewDocument(r *biz.Review, ts int64) map[string]any {
+	return map[string]any{
+		"id":            r.ID,
+		"user_id":       r.UserID,
+		"subject":       r.Subject,
+		"content":       r.Content,
+		"rating":        r.Rating,
+		"status":        r.Status,
+		"audit_reason":  r.AuditReason,
+		"audit_by":      r.AuditBy,
+		"audit_at":      nullableTime(r.AuditAt),
+		"reply_count":   r.ReplyCount,
+		"last_reply_at": nullableTime(r.LastReplyAt),
+		"created_at":    r.CreatedAt,
+		"updated_at":    r.UpdatedAt,
+		"version":       r.Version,
+		"ts":            ts,
+	}
+}
+
+// nullableTime leaves unset times out of range queries and sorts.
+func nullableTime(ts int64) any {
+	if ts == 0 {
+		return nil
+	}
+	return ts
+}
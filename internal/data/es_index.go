@@ -0,0 +1,340 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"review-service/internal/conf"
+
+	esv8 "github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ESTemplateVersion is bumped whenever the mapping in Template changes. Indices created
+// before a bump keep their old mapping until they are reindexed.
+const ESTemplateVersion = 3
+
+const defaultESAnalyzer = "cjk"
+
+// ESWriteAliasRefresh is how long writers cache the indices behind the write
+// alias. Reindex waits for it before backfilling, so no write is missed.
+const ESWriteAliasRefresh = 10 * time.Second
+
+// ESIndexManager owns the index template, and so the mapping, of the reviews
+// index, and the two aliases in front of it: readers search the read alias
+// (the configured index name) and writers write to every index behind the
+// write alias ("<index>-write"). Concrete indices are named "<index>-v<N>-<time>",
+// the first one "<index>-v<N>-initial", and matched by the template through
+// "<index>-*".
+type ESIndexManager struct {
+	es             *esv8.Client
+	index          string
+	analyzer       string
+	searchAnalyzer string
+	log            *log.Helper
+
+	mu        sync.Mutex
+	writeIdx  []string
+	writeTime time.Time
+}
+
+// NewESIndexManager creates a manager for the index configured in c.
+func NewESIndexManager(es *esv8.Client, c *conf.Data_Elasticsearch, logger log.Logger) *ESIndexManager {
+	m := &ESIndexManager{
+		es:       es,
+		index:    c.GetIndex(),
+		analyzer: c.GetAnalyzer(),
+		log:      log.NewHelper(logger),
+	}
+	if m.index == "" {
+		m.index = "reviews"
+	}
+	if m.analyzer == "" {
+		m.analyzer = defaultESAnalyzer
+	}
+	m.searchAnalyzer = c.GetSearchAnalyzer()
+	if m.searchAnalyzer == "" {
+		m.searchAnalyzer = m.analyzer
+	}
+	return m
+}
+
+// TemplateName is the name of the managed index template.
+func (m *ESIndexManager) TemplateName() string { return m.index + "-template" }
+
+// ReadAlias is the name searched by the service.
+func (m *ESIndexManager) ReadAlias() string { return m.index }
+
+// WriteAlias points at every index that must receive writes.
+func (m *ESIndexManager) WriteAlias() string { return m.index + "-write" }
+
+// newIndexName returns a fresh concrete index name for the current template.
+func (m *ESIndexManager) newIndexName() string {
+	return fmt.Sprintf("%s-v%d-%s", m.index, ESTemplateVersion, time.Now().UTC().Format("20060102150405"))
+}
+
+// initialIndexName is the first index created behind the aliases. It is
+// fixed, so concurrent Apply calls on a fresh cluster create the same index.
+func (m *ESIndexManager) initialIndexName() string {
+	return fmt.Sprintf("%s-v%d-initial", m.index, ESTemplateVersion)
+}
+
+// Template returns the index template body.
+func (m *ESIndexManager) Template() map[string]any {
+	text := map[string]any{
+		"type":            "text",
+		"analyzer":        m.analyzer,
+		"search_analyzer": m.searchAnalyzer,
+	}
+	epoch := map[string]any{"type": "date", "format": "epoch_second"}
+	return map[string]any{
+		"index_patterns": []string{m.index + "-*"},
+		"version":        ESTemplateVersion,
+		"priority":       100,
+		"template": map[string]any{
+			"settings": map[string]any{
+				"number_of_shards":   1,
+				"number_of_replicas": 1,
+			},
+			"mappings": map[string]any{
+				// unknown fields stay in _source but are not indexed
+				"dynamic": false,
+				"properties": map[string]any{
+					"id":            map[string]any{"type": "long"},
+					"user_id":       map[string]any{"type": "long"},
+					"subject":       text,
+					"content":       text,
+					"rating":        map[string]any{"type": "integer"},
+					"status":        map[string]any{"type": "keyword"},
+					"audit_reason":  map[string]any{"type": "text", "index": false},
+					"audit_by":      map[string]any{"type": "long"},
+					"audit_at":      epoch,
+					"created_at":    epoch,
+					"updated_at":    epoch,
+					"reply_count":   map[string]any{"type": "integer"},
+					"last_reply_at": epoch,
+					"version":       map[string]any{"type": "long"},
+					"ts":            epoch,
+				},
+			},
+		},
+	}
+}
+
+// Apply installs the template when the cluster has an older (or no) version
+// of it, then creates the first index behind both aliases if none exists.
+// It is safe to run concurrently: the first index has a fixed name, creating
+// it again is not an error, and an alias is only pointed at it while the
+// alias has no index yet.
+func (m *ESIndexManager) Apply(ctx context.Context) error {
+	current, err := m.installedVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if current < ESTemplateVersion {
+		b, _ := json.Marshal(m.Template())
+		res, err := m.es.Indices.PutIndexTemplate(m.TemplateName(), bytes.NewReader(b), m.es.Indices.PutIndexTemplate.WithContext(ctx))
+		if err := esResult(res, err); err != nil {
+			return fmt.Errorf("put index template: %w", err)
+		}
+		m.log.Infof("es index template %s updated: v%d -> v%d", m.TemplateName(), current, ESTemplateVersion)
+	}
+
+	readIdx, err := m.aliasIndices(ctx, m.ReadAlias())
+	if err != nil {
+		return err
+	}
+	if len(readIdx) > 0 {
+		if current < ESTemplateVersion && current > 0 {
+			m.log.Warnf("es index %v keeps its old mapping until it is reindexed", readIdx)
+		}
+		return m.aliasIfEmpty(ctx, readIdx, m.WriteAlias())
+	}
+
+	res, err := m.es.Indices.Exists([]string{m.index}, m.es.Indices.Exists.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	switch res.StatusCode {
+	case http.StatusOK:
+		// created before aliases were managed; writers fall back to it by name
+		m.log.Warnf("es index %s is not behind an alias; run `review-task reindex` to migrate it", m.index)
+		return nil
+	case http.StatusNotFound:
+		name := m.initialIndexName()
+		err := m.createIndex(ctx, name)
+		switch {
+		case err == nil:
+			m.log.Infof("es index %s created", name)
+		case !errors.Is(err, errESIndexExists):
+			return err
+		}
+		for _, alias := range []string{m.ReadAlias(), m.WriteAlias()} {
+			if err := m.aliasIfEmpty(ctx, []string{name}, alias); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("check index %s: %s", m.index, res.Status())
+	}
+}
+
+// aliasIfEmpty points alias at indices unless it already has an index.
+func (m *ESIndexManager) aliasIfEmpty(ctx context.Context, indices []string, alias string) error {
+	cur, err := m.aliasIndices(ctx, alias)
+	if err != nil || len(cur) > 0 {
+		return err
+	}
+	if err := m.updateAliases(ctx, aliasActions(nil, indices, alias)); err != nil {
+		return err
+	}
+	m.log.Infof("es alias %s points at %v", alias, indices)
+	return nil
+}
+
+// WriteIndices returns the indices behind the write alias, cached for
+// ESWriteAliasRefresh. A legacy index without aliases is written by name.
+func (m *ESIndexManager) WriteIndices(ctx context.Context) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.writeIdx != nil && time.Since(m.writeTime) < ESWriteAliasRefresh {
+		return m.writeIdx, nil
+	}
+	idx, err := m.aliasIndices(ctx, m.WriteAlias())
+	if err != nil {
+		return nil, err
+	}
+	if len(idx) == 0 {
+		idx = []string{m.index}
+	}
+	m.writeIdx, m.writeTime = idx, time.Now()
+	return idx, nil
+}
+
+// errESIndexExists is returned by createIndex when the index already exists.
+var errESIndexExists = errors.New("index already exists")
+
+// createIndex creates name with the mapping of the template; aliases are
+// added separately.
+func (m *ESIndexManager) createIndex(ctx context.Context, name string) error {
+	res, err := m.es.Indices.Create(name, m.es.Indices.Create.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("create index %s: %w", name, err)
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		if res.StatusCode == http.StatusBadRequest && bytes.Contains(msg, []byte("resource_already_exists_exception")) {
+			return fmt.Errorf("create index %s: %w", name, errESIndexExists)
+		}
+		return fmt.Errorf("create index %s: %s: %s", name, res.Status(), msg)
+	}
+	return nil
+}
+
+// aliasIndices returns the indices an alias points at, none if it does not exist.
+func (m *ESIndexManager) aliasIndices(ctx context.Context, alias string) ([]string, error) {
+	res, err := m.es.Indices.GetAlias(m.es.Indices.GetAlias.WithName(alias), m.es.Indices.GetAlias.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if res.IsError() {
+		return nil, fmt.Errorf("get alias %s: %s", alias, res.Status())
+	}
+	var parsed map[string]json.RawMessage
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return nil, err
+	}
+	out := make([]string, 0, len(parsed))
+	for idx := range parsed {
+		out = append(out, idx)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// aliasActions builds _aliases actions moving alias from the remove indices
+// to the add indices.
+func aliasActions(remove, add []string, alias string) []map[string]any {
+	var out []map[string]any
+	for _, idx := range remove {
+		out = append(out, map[string]any{"remove": map[string]any{"index": idx, "alias": alias}})
+	}
+	for _, idx := range add {
+		out = append(out, map[string]any{"add": map[string]any{"index": idx, "alias": alias}})
+	}
+	return out
+}
+
+// updateAliases applies all actions atomically.
+func (m *ESIndexManager) updateAliases(ctx context.Context, actions []map[string]any) error {
+	if len(actions) == 0 {
+		return nil
+	}
+	b, _ := json.Marshal(map[string]any{"actions": actions})
+	res, err := m.es.Indices.UpdateAliases(bytes.NewReader(b), m.es.Indices.UpdateAliases.WithContext(ctx))
+	if err := esResult(res, err); err != nil {
+		return fmt.Errorf("update aliases: %w", err)
+	}
+	return nil
+}
+
+// installedVersion returns the version of the installed template, 0 if absent.
+func (m *ESIndexManager) installedVersion(ctx context.Context) (int, error) {
+	res, err := m.es.Indices.GetIndexTemplate(
+		m.es.Indices.GetIndexTemplate.WithName(m.TemplateName()),
+		m.es.Indices.GetIndexTemplate.WithContext(ctx),
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return 0, nil
+	}
+	if res.IsError() {
+		return 0, fmt.Errorf("get index template: %s", res.Status())
+	}
+	var parsed struct {
+		IndexTemplates []struct {
+			IndexTemplate struct {
+				Version int `json:"version"`
+			} `json:"index_template"`
+		} `json:"index_templates"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return 0, err
+	}
+	if len(parsed.IndexTemplates) == 0 {
+		return 0, nil
+	}
+	return parsed.IndexTemplates[0].IndexTemplate.Version, nil
+}
+
+// esResult closes res and turns transport and HTTP failures into an error.
+func esResult(res *esapi.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		b, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("%s: %s", res.Status(), b)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"review-service/internal/conf"
+
+	esv8 "github.com/elastic/go-elasticsearch/v8"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeES implements the index, alias and template APIs ESIndexManager uses.
+type fakeES struct {
+	mu        sync.Mutex
+	templates map[string]json.RawMessage
+	// index -> aliases
+	indices map[string]map[string]bool
+}
+
+func newFakeES() *fakeES {
+	return &fakeES{templates: map[string]json.RawMessage{}, indices: map[string]map[string]bool{}}
+}
+
+func (f *fakeES) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w.Header().Set("X-Elastic-Product", "Elasticsearch")
+	w.Header().Set("Content-Type", "application/json")
+	path := strings.Trim(r.URL.Path, "/")
+	switch {
+	case strings.HasPrefix(path, "_index_template/"):
+		name := strings.TrimPrefix(path, "_index_template/")
+		if r.Method == http.MethodPut {
+			var body json.RawMessage
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			f.templates[name] = body
+			_, _ = w.Write([]byte(`{"acknowledged":true}`))
+			return
+		}
+		t, ok := f.templates[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"index_templates": []any{map[string]any{"name": name, "index_template": t}}})
+	case strings.HasPrefix(path, "_alias/"):
+		alias := strings.TrimPrefix(path, "_alias/")
+		out := map[string]any{}
+		for idx, aliases := range f.indices {
+			if aliases[alias] {
+				out[idx] = map[string]any{"aliases": map[string]any{alias: map[string]any{}}}
+			}
+		}
+		if len(out) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		_ = json.NewEncoder(w).Encode(out)
+	case path == "_aliases":
+		var body struct {
+			Actions []map[string]struct {
+				Index string `json:"index"`
+				Alias string `json:"alias"`
+			} `json:"actions"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		for _, a := range body.Actions {
+			for op, act := range a {
+				if f.indices[act.Index] == nil {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"error":{"type":"index_not_found_exception"}}`))
+					return
+				}
+				f.indices[act.Index][act.Alias] = op == "add"
+			}
+		}
+		_, _ = w.Write([]byte(`{"acknowledged":true}`))
+	case r.Method == http.MethodHead:
+		if f.indices[path] == nil && len(f.aliasIndices(path)) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case r.Method == http.MethodPut:
+		if f.indices[path] != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"type":"resource_already_exists_exception"},"status":400}`))
+			return
+		}
+		f.indices[path] = map[string]bool{}
+		_, _ = w.Write([]byte(`{"acknowledged":true}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{}`))
+	}
+}
+
+func (f *fakeES) aliasIndices(alias string) []string {
+	var out []string
+	for idx, aliases := range f.indices {
+		if aliases[alias] {
+			out = append(out, idx)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+func TestESIndexManagerApplyConcurrently(t *testing.T) {
+	fake := newFakeES()
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	es, err := esv8.NewClient(esv8.Config{Addresses: []string{srv.URL}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// every replica of review-task applies the template at startup
+	var wg sync.WaitGroup
+	errs := make([]error, 8)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m := NewESIndexManager(es, &conf.Data_Elasticsearch{Index: "reviews"}, log.DefaultLogger)
+			errs[i] = m.Apply(context.Background())
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("apply: %v", err)
+		}
+	}
+
+	want := []string{"reviews-v3-initial"}
+	if len(fake.indices) != 1 {
+		t.Errorf("indices = %v, want only %v", fake.indices, want)
+	}
+	for _, alias := range []string{"reviews", "reviews-write"} {
+		if got := fake.aliasIndices(alias); !slices.Equal(got, want) {
+			t.Errorf("alias %s -> %v, want %v", alias, got, want)
+		}
+	}
+}
+
+func TestESIndexManagerApplyKeepsAliases(t *testing.T) {
+	fake := newFakeES()
+	fake.indices["reviews-v3-20240101000000"] = map[string]bool{"reviews": true}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	es, err := esv8.NewClient(esv8.Config{Addresses: []string{srv.URL}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := NewESIndexManager(es, &conf.Data_Elasticsearch{Index: "reviews"}, log.DefaultLogger)
+	for range 2 {
+		if err := m.Apply(context.Background()); err != nil {
+			t.Fatalf("apply: %v", err)
+		}
+	}
+	want := []string{"reviews-v3-20240101000000"}
+	if len(fake.indices) != 1 {
+		t.Errorf("indices = %v, want only %v", fake.indices, want)
+	}
+	for _, alias := range []string{"reviews", "reviews-write"} {
+		if got := fake.aliasIndices(alias); !slices.Equal(got, want) {
+			t.Errorf("alias %s -> %v, want %v", alias, got, want)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ReindexResult describes a completed reindex.
+type ReindexResult struct {
+	Index    string   // new index now behind both aliases
+	Old      []string // indices that were behind the read alias before
+	Indexed  int      // rows copied from MySQL
+	Existing int      // rows skipped because a writer indexed a newer version first
+}
+
+// Reindex copies every review from MySQL into a new index and then moves
+// both aliases to it in one atomic _aliases call, so searches never see a
+// partial index:
+//
+//  1. create "<index>-v<N>-<time>" with the current template;
+//  2. add it to the write alias, so live writers fill it as well, and wait
+//     ESWriteAliasRefresh for them to notice;
+//  3. stream reviews by id into it, externally versioned like the writers'
+//     documents, so a newer document a writer has already indexed is kept;
+//  4. swap the read alias and drop the old indices from the write alias.
+//
+// A legacy concrete index named like the read alias is deleted in step 4 so
+// the alias can take over its name. Old aliased indices are deleted only if
+// deleteOld is set.
+func (m *ESIndexManager) Reindex(ctx context.Context, db *sql.DB, batchSize int, deleteOld bool) (*ReindexResult, error) {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if err := m.Apply(ctx); err != nil {
+		return nil, err
+	}
+	readIdx, err := m.aliasIndices(ctx, m.ReadAlias())
+	if err != nil {
+		return nil, err
+	}
+	legacy := false
+	if len(readIdx) == 0 {
+		res, err := m.es.Indices.Exists([]string{m.index}, m.es.Indices.Exists.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		res.Body.Close()
+		legacy = res.StatusCode == http.StatusOK
+	}
+	writeIdx, err := m.aliasIndices(ctx, m.WriteAlias())
+	if err != nil {
+		return nil, err
+	}
+
+	name := m.newIndexName()
+	if err := m.createIndex(ctx, name); err != nil {
+		return nil, err
+	}
+	out := &ReindexResult{Index: name, Old: readIdx}
+	// a legacy index was written by name; keep it in the write alias meanwhile
+	add := []string{name}
+	if legacy && len(writeIdx) == 0 {
+		add = append(add, m.index)
+	}
+	if err := m.updateAliases(ctx, aliasActions(nil, add, m.WriteAlias())); err != nil {
+		m.abortReindex(name)
+		return nil, err
+	}
+	m.log.Infof("reindex: created %s, waiting %s for writers", name, ESWriteAliasRefresh)
+	select {
+	case <-time.After(ESWriteAliasRefresh):
+	case <-ctx.Done():
+		m.abortReindex(name)
+		return nil, ctx.Err()
+	}
+
+	if err := m.backfill(ctx, db, name, batchSize, out); err != nil {
+		m.abortReindex(name)
+		return nil, err
+	}
+	res, err := m.es.Indices.Refresh(m.es.Indices.Refresh.WithIndex(name), m.es.Indices.Refresh.WithContext(ctx))
+	if err := esResult(res, err); err != nil {
+		m.log.Warnf("reindex: refresh %s failed: %v", name, err)
+	}
+
+	actions := aliasActions(readIdx, []string{name}, m.ReadAlias())
+	if legacy {
+		actions = append([]map[string]any{{"remove_index": map[string]any{"index": m.index}}}, actions...)
+		out.Old = []string{m.index}
+	}
+	var oldWrite []string
+	for _, idx := range writeIdx {
+		if idx != name && !(legacy && idx == m.index) {
+			oldWrite = append(oldWrite, idx)
+		}
+	}
+	actions = append(actions, aliasActions(oldWrite, nil, m.WriteAlias())...)
+	if err := m.updateAliases(ctx, actions); err != nil {
+		m.abortReindex(name)
+		return nil, err
+	}
+	m.log.Infof("reindex: %s now serves %s and %s", name, m.ReadAlias(), m.WriteAlias())
+
+	if deleteOld && len(readIdx) > 0 {
+		res, err := m.es.Indices.Delete(readIdx, m.es.Indices.Delete.WithContext(ctx))
+		if err := esResult(res, err); err != nil {
+			m.log.Warnf("reindex: delete old indices %v: %v", readIdx, err)
+		}
+	}
+	return out, nil
+}
+
+func (m *ESIndexManager) backfill(ctx context.Context, db *sql.DB, index string, batchSize int, out *ReindexResult) error {
+	var lastID uint64
+	for {
+		rows, err := db.QueryContext(ctx, `
+			SELECT `+reviewColumns+` FROM reviews WHERE id > ? AND deleted_at IS NULL ORDER BY id ASC LIMIT ?
+		`, lastID, batchSize)
+		if err != nil {
+			return err
+		}
+		list, err := scanReviews(rows)
+		if err != nil {
+			return err
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		ops := make([]ESBulkOp, len(list))
+		for i, rev := range list {
+			ops[i] = ESBulkOp{Action: "index", Index: index, ID: fmt.Sprint(rev.ID), Doc: ReviewDocument(rev, rev.UpdatedAt), Version: rev.Version}
+		}
+		results, err := ESBulk(ctx, m.es, ops)
+		if err != nil {
+			return err
+		}
+		for i, r := range results {
+			switch {
+			case r.Status == http.StatusConflict:
+				out.Existing++
+			case r.Failed():
+				return fmt.Errorf("index review %s: %s", ops[i].ID, r.Error)
+			default:
+				out.Indexed++
+			}
+		}
+		lastID = list[len(list)-1].ID
+		m.log.Infof("reindex: copied up to id %d (%d indexed)", lastID, out.Indexed)
+	}
+}
+
+// abortReindex drops a half-built index, which also removes it from the aliases.
+func (m *ESIndexManager) abortReindex(name string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	res, err := m.es.Indices.Delete([]string{name}, m.es.Indices.Delete.WithContext(ctx))
+	if err := esResult(res, err); err != nil {
+		m.log.Errorf("reindex: delete %s after failure: %v", name, err)
+	}
+}
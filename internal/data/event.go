@@ -0,0 +1,68 @@
+package data
+
+import (
+	"fmt"
+	"time"
+
+	eventsv1 "review-service/api/review/events/v1"
+	"review-service/internal/biz"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ReviewEventSchemaVersion is the version of the ReviewEvent envelope written
+// by this build. Bump it on changes old consumers cannot safely ignore.
+const ReviewEventSchemaVersion = 1
+
+func newReviewEvent(typ eventsv1.EventType, rev *biz.Review) *eventsv1.ReviewEvent {
+	return &eventsv1.ReviewEvent{
+		SchemaVersion:    ReviewEventSchemaVersion,
+		EventId:          uuid.NewString(),
+		Type:             typ,
+		AggregateId:      rev.ID,
+		AggregateVersion: rev.Version,
+		OccurredAt:       timestamppb.New(time.Now()),
+		Review:           ReviewSnapshot(rev),
+	}
+}
+
+// UnmarshalReviewEvent decodes a Kafka message value written by the outbox relay.
+func UnmarshalReviewEvent(b []byte) (*eventsv1.ReviewEvent, error) {
+	var evt eventsv1.ReviewEvent
+	if err := proto.Unmarshal(b, &evt); err != nil {
+		return nil, fmt.Errorf("unmarshal review event: %w", err)
+	}
+	if evt.SchemaVersion < 1 || evt.SchemaVersion > ReviewEventSchemaVersion {
+		return nil, fmt.Errorf("unsupported review event schema version %d", evt.SchemaVersion)
+	}
+	if evt.Review == nil {
+		return nil, fmt.Errorf("review event %s has no snapshot", evt.EventId)
+	}
+	return &evt, nil
+}
+
+// ReviewSnapshot converts a review into its event form.
+func ReviewSnapshot(r *biz.Review) *eventsv1.ReviewSnapshot {
+	return &eventsv1.ReviewSnapshot{
+		Id: r.ID, UserId: r.UserID, Subject: r.Subject, Content: r.Content, Rating: r.Rating, Status: r.Status,
+		AuditReason: r.AuditReason, AuditBy: r.AuditBy, AuditAt: r.AuditAt,
+		StatusChangedBy: r.StatusChangedBy, StatusChangedAt: r.StatusChangedAt,
+		ReplyCount: r.ReplyCount, LastReplyAt: r.LastReplyAt,
+		CreatedAt: r.CreatedAt, UpdatedAt: r.UpdatedAt, Version: r.Version,
+		DeletedAt: r.DeletedAt, DeletedBy: r.DeletedBy,
+	}
+}
+
+// ReviewFromSnapshot is the inverse of ReviewSnapshot.
+func ReviewFromSnapshot(s *eventsv1.ReviewSnapshot) *biz.Review {
+	return &biz.Review{
+		ID: s.Id, UserID: s.UserId, Subject: s.Subject, Content: s.Content, Rating: s.Rating, Status: s.Status,
+		AuditReason: s.AuditReason, AuditBy: s.AuditBy, AuditAt: s.AuditAt,
+		StatusChangedBy: s.StatusChangedBy, StatusChangedAt: s.StatusChangedAt,
+		ReplyCount: s.ReplyCount, LastReplyAt: s.LastReplyAt,
+		CreatedAt: s.CreatedAt, UpdatedAt: s.UpdatedAt, Version: s.Version,
+		DeletedAt: s.DeletedAt, DeletedBy: s.DeletedBy,
+	}
+}
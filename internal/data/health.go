@@ -0,0 +1,100 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"review-service/internal/biz"
+
+	kafka "github.com/segmentio/kafka-go"
+)
+
+type healthRepo struct {
+	data *Data
+}
+
+// NewHealthRepo .
+func NewHealthRepo(data *Data) biz.HealthRepo {
+	return &healthRepo{data: data}
+}
+
+func (r *healthRepo) Dependencies() []string {
+	deps := []string{biz.DependencyMySQL}
+	if r.data.RDB != nil {
+		deps = append(deps, biz.DependencyRedis)
+	}
+	if r.data.ES != nil {
+		deps = append(deps, biz.DependencyElasticsearch)
+	}
+	if r.data.Kafka != nil {
+		deps = append(deps, biz.DependencyKafka)
+	}
+	return deps
+}
+
+func (r *healthRepo) Ping(ctx context.Context, dependency string) error {
+	switch dependency {
+	case biz.DependencyMySQL:
+		return r.data.DB.PingContext(ctx)
+	case biz.DependencyRedis:
+		return r.data.RDB.Ping(ctx).Err()
+	case biz.DependencyElasticsearch:
+		return r.pingES(ctx)
+	case biz.DependencyKafka:
+		return r.pingKafka(ctx)
+	default:
+		return fmt.Errorf("unknown dependency %q", dependency)
+	}
+}
+
+// pingES fails while the cluster is red; yellow, e.g. a single node without
+// replicas, still serves every search and write.
+func (r *healthRepo) pingES(ctx context.Context) error {
+	es := r.data.ES
+	res, err := es.Cluster.Health(es.Cluster.Health.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return esResult(res, nil)
+	}
+	var health struct {
+		Status string `json:"status"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&health); err != nil {
+		return err
+	}
+	if health.Status == "red" {
+		return fmt.Errorf("cluster status is red")
+	}
+	return nil
+}
+
+// pingKafka fetches the metadata of the outbox topic through the writer's
+// brokers and transport, failing when no broker answers or the topic has no
+// leader to publish to.
+func (r *healthRepo) pingKafka(ctx context.Context) error {
+	w := r.data.Kafka
+	client := &kafka.Client{Addr: w.Addr, Transport: w.Transport}
+	meta, err := client.Metadata(ctx, &kafka.MetadataRequest{Topics: []string{w.Topic}})
+	if err != nil {
+		return err
+	}
+	for _, t := range meta.Topics {
+		if t.Name != w.Topic {
+			continue
+		}
+		if t.Error != nil {
+			return fmt.Errorf("topic %s: %w", w.Topic, t.Error)
+		}
+		for _, p := range t.Partitions {
+			if p.Error != nil {
+				return fmt.Errorf("topic %s partition %d: %w", w.Topic, p.ID, p.Error)
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("topic %s not found", w.Topic)
+}
@@ -0,0 +1,23 @@
+package data
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Data layer metrics, registered with the default Prometheus registry that
+// the HTTP server exposes at /metrics.
+var (
+	cacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "review_cache_requests_total",
+		Help: "Review cache lookups by result (hit|miss).",
+	}, []string{"result"})
+	searchFallbacks = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "review_search_fallbacks_total",
+		Help: "Review searches served by MySQL after Elasticsearch failed.",
+	})
+	kafkaPublishFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "review_outbox_publish_failures_total",
+		Help: "Outbox events Kafka did not accept; they are retried with backoff.",
+	})
+)
@@ -0,0 +1,429 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+//go:embed migrations/*.sql
+var migrationFS embed.FS
+
+const migrationTable = "schema_migrations"
+
+// migrationLock is the MySQL named lock held while migrating, so instances
+// started together do not apply the same migration twice.
+const (
+	migrationLock        = "review-service.schema_migrations"
+	migrationLockTimeout = 5 * time.Minute
+)
+
+// Migration is one versioned schema change loaded from migrations/.
+// Files are named <version>_<name>.up.sql and <version>_<name>.down.sql.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus reports whether a migration has been applied. A dirty
+// migration failed partway: some of its statements may have been applied.
+type MigrationStatus struct {
+	Version   int
+	Name      string
+	AppliedAt *time.Time
+	Dirty     bool
+}
+
+// appliedMigration is a row of schema_migrations.
+type appliedMigration struct {
+	at    time.Time
+	dirty bool
+}
+
+// Migrator applies the embedded migrations and records them in schema_migrations.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+	log        *log.Helper
+}
+
+// NewMigrator loads the embedded migrations for db.
+func NewMigrator(db *sql.DB, logger log.Logger) (*Migrator, error) {
+	ms, err := loadMigrations(migrationFS, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: ms, log: log.NewHelper(logger)}, nil
+}
+
+// Up applies every pending migration in version order and returns how many ran.
+// MySQL commits DDL implicitly, so a migration whose statements fail partway
+// cannot be rolled back: it is recorded as dirty, and Up and Down refuse to
+// run until an operator repairs the schema and calls Force.
+func (m *Migrator) Up(ctx context.Context) (n int, err error) {
+	err = m.withLock(ctx, func() error {
+		n, err = m.up(ctx)
+		return err
+	})
+	return n, err
+}
+
+func (m *Migrator) up(ctx context.Context) (int, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return 0, err
+	}
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := m.checkDirty(applied); err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, mg := range m.migrations {
+		if _, ok := applied[mg.Version]; ok {
+			continue
+		}
+		m.log.WithContext(ctx).Infof("migrate up %04d_%s", mg.Version, mg.Name)
+		if _, err := m.db.ExecContext(ctx, `INSERT INTO `+migrationTable+` (version, name, dirty) VALUES (?, ?, 1)`, mg.Version, mg.Name); err != nil {
+			return n, err
+		}
+		if err := m.exec(ctx, mg.Up); err != nil {
+			return n, fmt.Errorf("migration %04d_%s up, now dirty: %w", mg.Version, mg.Name, err)
+		}
+		if _, err := m.db.ExecContext(ctx, `UPDATE `+migrationTable+` SET dirty = 0, applied_at = CURRENT_TIMESTAMP WHERE version = ?`, mg.Version); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// Down rolls back the latest steps applied migrations. It stops at the first
+// migration without a down script, which cannot be rolled back. Like Up, it
+// leaves a migration that fails partway dirty.
+func (m *Migrator) Down(ctx context.Context, steps int) (n int, err error) {
+	err = m.withLock(ctx, func() error {
+		n, err = m.down(ctx, steps)
+		return err
+	})
+	return n, err
+}
+
+func (m *Migrator) down(ctx context.Context, steps int) (int, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return 0, err
+	}
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := m.checkDirty(applied); err != nil {
+		return 0, err
+	}
+	n := 0
+	for i := len(m.migrations) - 1; i >= 0 && n < steps; i-- {
+		mg := m.migrations[i]
+		if _, ok := applied[mg.Version]; !ok {
+			continue
+		}
+		if len(splitStatements(mg.Down)) == 0 {
+			return n, fmt.Errorf("migration %04d_%s has no down script", mg.Version, mg.Name)
+		}
+		m.log.WithContext(ctx).Infof("migrate down %04d_%s", mg.Version, mg.Name)
+		if _, err := m.db.ExecContext(ctx, `UPDATE `+migrationTable+` SET dirty = 1 WHERE version = ?`, mg.Version); err != nil {
+			return n, err
+		}
+		if err := m.exec(ctx, mg.Down); err != nil {
+			return n, fmt.Errorf("migration %04d_%s down, now dirty: %w", mg.Version, mg.Name, err)
+		}
+		if _, err := m.db.ExecContext(ctx, `DELETE FROM `+migrationTable+` WHERE version = ?`, mg.Version); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// Status lists every known migration with its applied time, if any.
+func (m *Migrator) Status(ctx context.Context) ([]*MigrationStatus, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*MigrationStatus, 0, len(m.migrations))
+	for _, mg := range m.migrations {
+		st := &MigrationStatus{Version: mg.Version, Name: mg.Name}
+		if a, ok := applied[mg.Version]; ok {
+			st.AppliedAt, st.Dirty = &a.at, a.dirty
+		}
+		out = append(out, st)
+	}
+	return out, nil
+}
+
+// Force clears the dirty flag of a migration once an operator has repaired
+// the schema by hand: applied records it as applied, otherwise it is recorded
+// as pending and Up runs it again.
+func (m *Migrator) Force(ctx context.Context, version int, applied bool) error {
+	return m.withLock(ctx, func() error {
+		if err := m.ensureTable(ctx); err != nil {
+			return err
+		}
+		var mg *Migration
+		for i := range m.migrations {
+			if m.migrations[i].Version == version {
+				mg = &m.migrations[i]
+			}
+		}
+		if mg == nil {
+			return fmt.Errorf("unknown migration version %d", version)
+		}
+		if !applied {
+			_, err := m.db.ExecContext(ctx, `DELETE FROM `+migrationTable+` WHERE version = ?`, version)
+			return err
+		}
+		_, err := m.db.ExecContext(ctx, `
+			INSERT INTO `+migrationTable+` (version, name, dirty) VALUES (?, ?, 0)
+			ON DUPLICATE KEY UPDATE dirty = 0
+		`, mg.Version, mg.Name)
+		return err
+	})
+}
+
+// checkDirty fails if a migration was left dirty by a failed run.
+func (m *Migrator) checkDirty(applied map[int]appliedMigration) error {
+	for _, mg := range m.migrations {
+		if a, ok := applied[mg.Version]; ok && a.dirty {
+			return fmt.Errorf("migration %04d_%s is dirty: a previous run failed partway; "+
+				"repair the schema, then run `migrate force %d applied|pending`", mg.Version, mg.Name, mg.Version)
+		}
+	}
+	return nil
+}
+
+// withLock runs fn holding migrationLock. Named locks belong to a session, so
+// the lock is taken and released on one dedicated connection.
+func (m *Migrator) withLock(ctx context.Context, fn func() error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	var got sql.NullInt64
+	if err := conn.QueryRowContext(ctx, `SELECT GET_LOCK(?, ?)`, migrationLock, int(migrationLockTimeout/time.Second)).Scan(&got); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	if got.Int64 != 1 {
+		return fmt.Errorf("acquire migration lock: another migration has held it for %s", migrationLockTimeout)
+	}
+	defer func() {
+		// released even if ctx is done; closing conn would release it as well
+		_, _ = conn.ExecContext(context.Background(), `SELECT RELEASE_LOCK(?)`, migrationLock)
+	}()
+	return fn()
+}
+
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS `+migrationTable+` (
+			version    INT          NOT NULL,
+			name       VARCHAR(255) NOT NULL,
+			applied_at DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			dirty      TINYINT(1)   NOT NULL DEFAULT 0,
+			PRIMARY KEY (version)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4
+	`)
+	if err != nil {
+		return err
+	}
+	// tables created before the dirty flag existed
+	var n int
+	err = m.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = 'dirty'
+	`, migrationTable).Scan(&n)
+	if err != nil || n > 0 {
+		return err
+	}
+	_, err = m.db.ExecContext(ctx, `ALTER TABLE `+migrationTable+` ADD COLUMN dirty TINYINT(1) NOT NULL DEFAULT 0`)
+	return err
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[int]appliedMigration, error) {
+	rows, err := m.db.QueryContext(ctx, `SELECT version, UNIX_TIMESTAMP(applied_at), dirty FROM `+migrationTable)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make(map[int]appliedMigration)
+	for rows.Next() {
+		var (
+			v     int
+			ts    int64
+			dirty bool
+		)
+		if err := rows.Scan(&v, &ts, &dirty); err != nil {
+			return nil, err
+		}
+		out[v] = appliedMigration{at: time.Unix(ts, 0), dirty: dirty}
+	}
+	return out, rows.Err()
+}
+
+// exec runs each statement of a migration file on its own, since the
+// MySQL driver rejects multi-statement queries by default.
+func (m *Migrator) exec(ctx context.Context, script string) error {
+	for _, stmt := range splitStatements(script) {
+		if _, err := m.db.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitStatements splits a script on the semicolons that end statements,
+// ignoring those in quoted strings, quoted identifiers and comments.
+// Fragments holding nothing but comments are dropped.
+func splitStatements(script string) []string {
+	var (
+		out  []string
+		cur  strings.Builder
+		code bool // cur holds more than comments and whitespace
+	)
+	flush := func() {
+		if code {
+			out = append(out, strings.TrimSpace(cur.String()))
+		}
+		cur.Reset()
+		code = false
+	}
+	for i := 0; i < len(script); i++ {
+		c := script[i]
+		end := -1
+		switch {
+		case c == ';':
+			flush()
+			continue
+		case c == '\'' || c == '"' || c == '`':
+			end, code = quoteEnd(script, i), true
+		case c == '#' || strings.HasPrefix(script[i:], "--") && (i+2 == len(script) || isSQLSpace(script[i+2])):
+			if end = strings.IndexByte(script[i:], '\n'); end < 0 {
+				end = len(script)
+			} else {
+				end += i
+			}
+		case strings.HasPrefix(script[i:], "/*"):
+			if end = strings.Index(script[i+2:], "*/"); end < 0 {
+				end = len(script)
+			} else {
+				end += i + 4
+			}
+		}
+		if end >= 0 {
+			cur.WriteString(script[i:end])
+			i = end - 1
+			continue
+		}
+		cur.WriteByte(c)
+		if !isSQLSpace(c) {
+			code = true
+		}
+	}
+	flush()
+	return out
+}
+
+// quoteEnd returns the index just past the string or identifier whose
+// opening quote is at s[start]. A doubled quote escapes it, and so does a
+// backslash in strings.
+func quoteEnd(s string, start int) int {
+	q := s[start]
+	for i := start + 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if q != '`' {
+				i++
+			}
+		case q:
+			if i+1 < len(s) && s[i+1] == q {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+func isSQLSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v'
+}
+
+func loadMigrations(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	byVersion := make(map[int]*Migration)
+	for _, e := range entries {
+		file := e.Name()
+		var up bool
+		var base string
+		switch {
+		case strings.HasSuffix(file, ".up.sql"):
+			up, base = true, strings.TrimSuffix(file, ".up.sql")
+		case strings.HasSuffix(file, ".down.sql"):
+			base = strings.TrimSuffix(file, ".down.sql")
+		default:
+			continue
+		}
+		verStr, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("migration %q: want <version>_<name>", file)
+		}
+		ver, err := strconv.Atoi(verStr)
+		if err != nil {
+			return nil, fmt.Errorf("migration %q: bad version: %w", file, err)
+		}
+		b, err := fs.ReadFile(fsys, path.Join(dir, file))
+		if err != nil {
+			return nil, err
+		}
+		mg, ok := byVersion[ver]
+		if !ok {
+			mg = &Migration{Version: ver, Name: name}
+			byVersion[ver] = mg
+		} else if mg.Name != name {
+			return nil, fmt.Errorf("migration %04d: conflicting names %q and %q", ver, mg.Name, name)
+		}
+		if up {
+			mg.Up = string(b)
+		} else {
+			mg.Down = string(b)
+		}
+	}
+	out := make([]Migration, 0, len(byVersion))
+	for _, mg := range byVersion {
+		if mg.Up == "" {
+			return nil, fmt.Errorf("migration %04d_%s: missing up script", mg.Version, mg.Name)
+		}
+		out = append(out, *mg)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Version < out[j].Version })
+	return out, nil
+}
@@ -0,0 +1,77 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TestMigratorDirty runs a migration whose second statement fails against
+// REVIEW_TEST_MYSQL_DSN, using a scratch version and table.
+func TestMigratorDirty(t *testing.T) {
+	dsn := os.Getenv("REVIEW_TEST_MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("REVIEW_TEST_MYSQL_DSN is not set")
+	}
+	ctx := context.Background()
+	db := openTestDB(ctx, t, dsn)
+	const version = 9001
+	cleanup := func() {
+		_, _ = db.ExecContext(ctx, `DROP TABLE IF EXISTS migrate_dirty_test`)
+		_, _ = db.ExecContext(ctx, `DELETE FROM `+migrationTable+` WHERE version = ?`, version)
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+
+	m := &Migrator{
+		db: db,
+		migrations: []Migration{{
+			Version: version,
+			Name:    "dirty_test",
+			Up:      "CREATE TABLE migrate_dirty_test (id INT); ALTER TABLE missing_table ADD COLUMN x INT",
+			Down:    "DROP TABLE migrate_dirty_test",
+		}},
+		log: log.NewHelper(log.DefaultLogger),
+	}
+	if _, err := m.Up(ctx); err == nil {
+		t.Fatal("Up succeeded, want the second statement to fail")
+	}
+	list, err := m.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !list[0].Dirty {
+		t.Fatalf("status = %+v, want dirty", list[0])
+	}
+	for name, run := range map[string]func() error{
+		"up":   func() error { _, err := m.Up(ctx); return err },
+		"down": func() error { _, err := m.Down(ctx, 1); return err },
+	} {
+		if err := run(); err == nil || !strings.Contains(err.Error(), "is dirty") {
+			t.Errorf("%s on a dirty migration = %v, want it refused", name, err)
+		}
+	}
+
+	// the operator undoes the first statement and marks it pending
+	if _, err := db.ExecContext(ctx, `DROP TABLE migrate_dirty_test`); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Force(ctx, version, false); err != nil {
+		t.Fatal(err)
+	}
+	m.migrations[0].Up = "CREATE TABLE migrate_dirty_test (id INT); ALTER TABLE migrate_dirty_test ADD COLUMN x INT"
+	if n, err := m.Up(ctx); err != nil || n != 1 {
+		t.Fatalf("Up after force = %d, %v, want 1, nil", n, err)
+	}
+	if list, err = m.Status(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if list[0].Dirty || list[0].AppliedAt == nil {
+		t.Errorf("status = %+v, want applied and clean", list[0])
+	}
+}
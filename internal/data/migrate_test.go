@@ -0,0 +1,82 @@
+package data
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestSplitStatements(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   []string
+	}{
+		{name: "empty", script: "", want: nil},
+		{name: "one without semicolon", script: "SELECT 1", want: []string{"SELECT 1"}},
+		{name: "several", script: "SELECT 1;\n SELECT 2 ;\n\n", want: []string{"SELECT 1", "SELECT 2"}},
+		{name: "semicolon in string", script: "INSERT INTO t VALUES ('a;b'); SELECT 2", want: []string{"INSERT INTO t VALUES ('a;b')", "SELECT 2"}},
+		{name: "escaped quotes", script: `SELECT 'it''s;', 'a\';b', "x"";y"; SELECT 2`, want: []string{`SELECT 'it''s;', 'a\';b', "x"";y"`, "SELECT 2"}},
+		{name: "semicolon in identifier", script: "CREATE TABLE `a;b` (id INT); SELECT 2", want: []string{"CREATE TABLE `a;b` (id INT)", "SELECT 2"}},
+		{name: "line comments", script: "-- drop it; later\nSELECT 1; # done; really\n", want: []string{"-- drop it; later\nSELECT 1"}},
+		{name: "double dash needs a space", script: "SELECT 1--1; SELECT 2", want: []string{"SELECT 1--1", "SELECT 2"}},
+		{name: "block comment", script: "SELECT /* a; b */ 1; /* only; a comment */", want: []string{"SELECT /* a; b */ 1"}},
+		{name: "unterminated string", script: "SELECT 'a;b", want: []string{"SELECT 'a;b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitStatements(tt.script); !slices.Equal(got, tt.want) {
+				t.Errorf("splitStatements(%q) = %q, want %q", tt.script, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	ms, err := loadMigrations(migrationFS, "migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, mg := range ms {
+		if mg.Version != i+1 {
+			t.Errorf("migration %04d_%s: versions must be consecutive from 1", mg.Version, mg.Name)
+		}
+		if len(splitStatements(mg.Up)) == 0 {
+			t.Errorf("migration %04d_%s: empty up script", mg.Version, mg.Name)
+		}
+		if len(splitStatements(mg.Down)) == 0 {
+			t.Errorf("migration %04d_%s: no down script", mg.Version, mg.Name)
+		}
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	fsys := fstest.MapFS{
+		"m/0002_b.up.sql":   {Data: []byte("B")},
+		"m/0001_a.up.sql":   {Data: []byte("A")},
+		"m/0001_a.down.sql": {Data: []byte("-A")},
+		"m/README.md":       {Data: []byte("ignored")},
+	}
+	ms, err := loadMigrations(fsys, "m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Migration{{Version: 1, Name: "a", Up: "A", Down: "-A"}, {Version: 2, Name: "b", Up: "B"}}
+	if !slices.Equal(ms, want) {
+		t.Errorf("loadMigrations() = %+v, want %+v", ms, want)
+	}
+
+	for name, fsys := range map[string]fstest.MapFS{
+		"missing up":        {"m/0001_a.down.sql": {Data: []byte("x")}},
+		"conflicting names": {"m/0001_a.up.sql": {Data: []byte("x")}, "m/0001_b.down.sql": {Data: []byte("x")}},
+		"bad version":       {"m/x_a.up.sql": {Data: []byte("x")}},
+		"no name":           {"m/0001.up.sql": {Data: []byte("x")}},
+	} {
+		if _, err := loadMigrations(fsys, "m"); err == nil {
+			t.Errorf("%s: loadMigrations() succeeded", name)
+		} else if !strings.Contains(err.Error(), "migration") {
+			t.Errorf("%s: unexpected error %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS reviews (
+    id           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    user_id      BIGINT UNSIGNED NOT NULL,
+    subject      VARCHAR(255)    NOT NULL DEFAULT '',
+    content      TEXT            NOT NULL,
+    rating       TINYINT         NOT NULL DEFAULT 0,
+    status       VARCHAR(16)     NOT NULL DEFAULT 'PENDING',
+    audit_reason VARCHAR(512)    NOT NULL DEFAULT '',
+    audit_by     BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    audit_at     DATETIME        NULL,
+    created_at   DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at   DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    KEY idx_reviews_user_id (user_id),
+    KEY idx_reviews_status (status)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
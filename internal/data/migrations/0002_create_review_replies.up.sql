@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS review_replies (
+    id          BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    review_id   BIGINT UNSIGNED NOT NULL,
+    merchant_id BIGINT UNSIGNED NOT NULL,
+    content     TEXT            NOT NULL,
+    created_at  DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    KEY idx_review_replies_review_id (review_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS review_outbox (
+    id              BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    aggregate_id    BIGINT UNSIGNED NOT NULL,
+    op              VARCHAR(32)     NOT NULL,
+    payload         MEDIUMBLOB      NOT NULL,
+    attempts        INT             NOT NULL DEFAULT 0,
+    last_error      VARCHAR(1024)   NOT NULL DEFAULT '',
+    next_attempt_at DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at      DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at         DATETIME        NULL,
+    PRIMARY KEY (id),
+    KEY idx_review_outbox_pending (sent_at, next_attempt_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
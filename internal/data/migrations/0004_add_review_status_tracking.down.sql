@@ -0,0 +1,4 @@
+ALTER TABLE reviews
+    DROP COLUMN status_changed_at,
+    DROP COLUMN status_changed_by,
+    DROP COLUMN appeal_reason;
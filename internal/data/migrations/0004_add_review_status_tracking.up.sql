@@ -0,0 +1,4 @@
+ALTER TABLE reviews
+    ADD COLUMN appeal_reason     VARCHAR(512)    NOT NULL DEFAULT '' AFTER audit_at,
+    ADD COLUMN status_changed_by BIGINT UNSIGNED NOT NULL DEFAULT 0 AFTER appeal_reason,
+    ADD COLUMN status_changed_at DATETIME        NULL AFTER status_changed_by;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS review_audit_logs (
+    id          BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    review_id   BIGINT UNSIGNED NOT NULL,
+    action      VARCHAR(16)     NOT NULL,
+    from_status VARCHAR(16)     NOT NULL,
+    to_status   VARCHAR(16)     NOT NULL,
+    operator_id BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    reason      VARCHAR(512)    NOT NULL DEFAULT '',
+    created_at  DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    KEY idx_review_audit_logs_review_id (review_id, id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1 @@
+ALTER TABLE reviews DROP INDEX ft_reviews_subject_content;
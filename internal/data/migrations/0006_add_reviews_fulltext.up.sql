@@ -0,0 +1 @@
+ALTER TABLE reviews ADD FULLTEXT INDEX ft_reviews_subject_content (subject, content) WITH PARSER ngram;
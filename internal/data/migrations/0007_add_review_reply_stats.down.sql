@@ -0,0 +1,3 @@
+ALTER TABLE reviews
+    DROP COLUMN last_reply_at,
+    DROP COLUMN reply_count;
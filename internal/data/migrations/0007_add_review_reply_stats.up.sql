@@ -0,0 +1,8 @@
+ALTER TABLE reviews
+    ADD COLUMN reply_count   INT UNSIGNED NOT NULL DEFAULT 0 AFTER status_changed_at,
+    ADD COLUMN last_reply_at DATETIME     NULL AFTER reply_count;
+
+UPDATE reviews r
+    JOIN (SELECT review_id, COUNT(*) AS n, MAX(created_at) AS last_at FROM review_replies GROUP BY review_id) rr
+      ON rr.review_id = r.id
+   SET r.reply_count = rr.n, r.last_reply_at = rr.last_at, r.updated_at = r.updated_at;
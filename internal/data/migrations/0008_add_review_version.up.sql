@@ -0,0 +1,2 @@
+ALTER TABLE reviews
+    ADD COLUMN version BIGINT UNSIGNED NOT NULL DEFAULT 1 AFTER last_reply_at;
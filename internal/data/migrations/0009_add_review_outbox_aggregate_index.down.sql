@@ -0,0 +1,2 @@
+ALTER TABLE review_outbox
+    DROP KEY idx_review_outbox_aggregate;
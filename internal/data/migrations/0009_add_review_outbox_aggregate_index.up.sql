@@ -0,0 +1,2 @@
+ALTER TABLE review_outbox
+    ADD KEY idx_review_outbox_aggregate (aggregate_id, sent_at);
@@ -0,0 +1,4 @@
+ALTER TABLE reviews
+    DROP KEY idx_reviews_deleted_at,
+    DROP COLUMN deleted_by,
+    DROP COLUMN deleted_at;
@@ -0,0 +1,4 @@
+ALTER TABLE reviews
+    ADD COLUMN deleted_at DATETIME        NULL AFTER version,
+    ADD COLUMN deleted_by BIGINT UNSIGNED NOT NULL DEFAULT 0 AFTER deleted_at,
+    ADD KEY idx_reviews_deleted_at (deleted_at);
@@ -0,0 +1,2 @@
+ALTER TABLE review_outbox
+    DROP COLUMN trace_context;
@@ -0,0 +1,2 @@
+ALTER TABLE review_outbox
+    ADD COLUMN trace_context VARCHAR(1024) NOT NULL DEFAULT '' AFTER payload;
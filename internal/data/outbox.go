@@ -0,0 +1,346 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"review-service/internal/conf"
+	"review-service/internal/telemetry"
+
+	"github.com/go-kratos/kratos/v2/log"
+	kafka "github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultRelayInterval  = time.Second
+	defaultRelayBatchSize = 100
+	maxRelayBackoff       = 5 * time.Minute
+	// how long a claimed row is left to the instance relaying it
+	outboxClaimLease = time.Minute
+
+	defaultOutboxRetention = 7 * 24 * time.Hour
+	outboxCleanupInterval  = 10 * time.Minute
+	outboxCleanupBatchSize = 1000
+)
+
+// enqueueEvent stores an event in review_outbox inside the caller's
+// transaction, so it is committed or rolled back with the domain change.
+// The trace context of ctx is stored with it and continued by the relay.
+func enqueueEvent(ctx context.Context, tx *sql.Tx, aggregateID uint64, op string, payload []byte) error {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	traceContext := ""
+	if len(carrier) > 0 {
+		b, err := json.Marshal(carrier)
+		if err != nil {
+			return err
+		}
+		traceContext = string(b)
+	}
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO review_outbox (aggregate_id, op, payload, trace_context) VALUES (?, ?, ?, ?)
+	`, aggregateID, op, payload, traceContext)
+	return err
+}
+
+// OutboxRelay publishes pending review_outbox rows to Kafka and marks them
+// sent once the broker has acknowledged them. Failed rows are retried with
+// exponential backoff, and sent rows are deleted after the retention period.
+// It runs as a kratos transport.Server.
+type OutboxRelay struct {
+	data      *Data
+	interval  time.Duration
+	batchSize int
+	retention time.Duration
+	log       *log.Helper
+	tracer    trace.Tracer
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewOutboxRelay creates the relay for the configured Kafka topic.
+func NewOutboxRelay(d *Data, c *conf.Data, logger log.Logger) *OutboxRelay {
+	r := &OutboxRelay{
+		data:      d,
+		interval:  defaultRelayInterval,
+		batchSize: defaultRelayBatchSize,
+		retention: defaultOutboxRetention,
+		log:       log.NewHelper(logger),
+		tracer:    otel.Tracer(tracerName),
+	}
+	if c.Kafka != nil {
+		if c.Kafka.RelayInterval != nil && c.Kafka.RelayInterval.AsDuration() > 0 {
+			r.interval = c.Kafka.RelayInterval.AsDuration()
+		}
+		if c.Kafka.RelayBatchSize > 0 {
+			r.batchSize = int(c.Kafka.RelayBatchSize)
+		}
+		if c.Kafka.SentRetention != nil && c.Kafka.SentRetention.AsDuration() > 0 {
+			r.retention = c.Kafka.SentRetention.AsDuration()
+		}
+	}
+	return r
+}
+
+// Start polls the outbox until Stop is called. It is a no-op without Kafka.
+func (r *OutboxRelay) Start(ctx context.Context) error {
+	if r.data.Kafka == nil {
+		r.log.Info("outbox relay disabled: kafka not configured")
+		return nil
+	}
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.done = make(chan struct{})
+	go r.run(ctx)
+	return nil
+}
+
+// Stop waits for the in-flight batch to finish.
+func (r *OutboxRelay) Stop(ctx context.Context) error {
+	if r.cancel == nil {
+		return nil
+	}
+	r.cancel()
+	select {
+	case <-r.done:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+func (r *OutboxRelay) run(ctx context.Context) {
+	defer close(r.done)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	var lastCleanup time.Time
+	for {
+		// drain full batches back to back, then wait for the next tick
+		for {
+			n, err := r.relayBatch(ctx)
+			if err != nil && ctx.Err() == nil {
+				r.log.Errorf("outbox relay: %v", err)
+			}
+			if err != nil || n < r.batchSize {
+				break
+			}
+		}
+		if time.Since(lastCleanup) >= outboxCleanupInterval {
+			lastCleanup = time.Now()
+			if err := r.cleanup(ctx); err != nil && ctx.Err() == nil {
+				r.log.Errorf("outbox cleanup: %v", err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// cleanup deletes rows sent more than retention ago, in batches so no single
+// statement holds locks for long.
+func (r *OutboxRelay) cleanup(ctx context.Context) error {
+	for {
+		res, err := r.data.DB.ExecContext(ctx, `
+			DELETE FROM review_outbox
+			WHERE sent_at IS NOT NULL AND sent_at < DATE_SUB(CURRENT_TIMESTAMP, INTERVAL ? SECOND)
+			LIMIT ?
+		`, int64(r.retention/time.Second), outboxCleanupBatchSize)
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil || n < outboxCleanupBatchSize {
+			return err
+		}
+	}
+}
+
+type outboxRow struct {
+	id           uint64
+	aggregateID  uint64
+	op           string
+	payload      []byte
+	traceContext string
+	attempts     int
+}
+
+// relayBatch claims up to batchSize due rows, writes them to Kafka and
+// records the outcome. Rows are claimed in a short transaction that pushes
+// their next_attempt_at out by outboxClaimLease, so no lock is held while
+// Kafka is written and several instances can relay in parallel (SKIP LOCKED).
+// A row is only claimed once every older row of the same review has been
+// sent, including one another instance has claimed or is backing off, so a
+// review's events reach Kafka in order; a review therefore moves by one event
+// per batch. A claim left by a crashed instance expires after the lease.
+func (r *OutboxRelay) relayBatch(ctx context.Context) (int, error) {
+	batch, err := r.claim(ctx)
+	if err != nil || len(batch) == 0 {
+		return 0, err
+	}
+
+	msgs := make([]kafka.Message, len(batch))
+	spans := make([]trace.Span, len(batch))
+	for i, it := range batch {
+		msgs[i] = kafka.Message{Key: []byte(strconv.FormatUint(it.aggregateID, 10)), Value: it.payload}
+		spans[i] = r.startPublish(ctx, it, &msgs[i])
+	}
+	// give up well before the claim expires, so no other instance sends
+	// these rows meanwhile
+	wctx, cancel := context.WithTimeout(ctx, outboxClaimLease/2)
+	werr := r.data.Kafka.WriteMessages(wctx, msgs...)
+	cancel()
+	var perMsg kafka.WriteErrors
+	errors.As(werr, &perMsg)
+
+	// the outcome is recorded even if ctx is done, or sent rows would be
+	// sent again once the claim expires
+	rctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+	tx, err := r.data.DB.BeginTx(rctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	nfailed := 0
+	for i, it := range batch {
+		failed := werr
+		if perMsg != nil {
+			failed = perMsg[i]
+		}
+		if failed != nil {
+			spans[i].RecordError(failed)
+			spans[i].SetStatus(codes.Error, failed.Error())
+		}
+		spans[i].End()
+		if failed == nil {
+			_, err = tx.ExecContext(rctx, `UPDATE review_outbox SET sent_at = CURRENT_TIMESTAMP, attempts = attempts + 1 WHERE id = ?`, it.id)
+		} else {
+			nfailed++
+			backoff := relayBackoff(it.attempts + 1)
+			_, err = tx.ExecContext(rctx, `
+				UPDATE review_outbox
+				SET attempts = attempts + 1, last_error = ?, next_attempt_at = DATE_ADD(CURRENT_TIMESTAMP, INTERVAL ? SECOND)
+				WHERE id = ?
+			`, truncate(failed.Error(), 1024), int64(backoff/time.Second), it.id)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	if nfailed > 0 {
+		kafkaPublishFailures.Add(float64(nfailed))
+		r.log.Warnf("outbox relay: kafka write failed for %d/%d event(s): %v", nfailed, len(batch), werr)
+	}
+	return len(batch), nil
+}
+
+// claim locks up to batchSize due rows whose older rows of the same review
+// are all sent, and leases them by moving next_attempt_at past the claim.
+// The NOT EXISTS subquery is a plain read, so it also sees older rows that
+// another instance has locked or claimed.
+func (r *OutboxRelay) claim(ctx context.Context) ([]outboxRow, error) {
+	tx, err := r.data.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id, aggregate_id, op, payload, trace_context, attempts FROM review_outbox
+		WHERE sent_at IS NULL AND next_attempt_at <= CURRENT_TIMESTAMP
+		  AND NOT EXISTS (
+			SELECT 1 FROM review_outbox prev
+			WHERE prev.aggregate_id = review_outbox.aggregate_id
+			  AND prev.sent_at IS NULL AND prev.id < review_outbox.id
+		  )
+		ORDER BY id ASC LIMIT ? FOR UPDATE SKIP LOCKED
+	`, r.batchSize)
+	if err != nil {
+		return nil, err
+	}
+	var batch []outboxRow
+	for rows.Next() {
+		var it outboxRow
+		if err := rows.Scan(&it.id, &it.aggregateID, &it.op, &it.payload, &it.traceContext, &it.attempts); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		batch = append(batch, it)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(batch) == 0 {
+		return nil, nil
+	}
+
+	args := make([]any, 0, len(batch)+1)
+	args = append(args, int64(outboxClaimLease/time.Second))
+	for _, it := range batch {
+		args = append(args, it.id)
+	}
+	_, err = tx.ExecContext(ctx, `
+		UPDATE review_outbox SET next_attempt_at = DATE_ADD(CURRENT_TIMESTAMP, INTERVAL ? SECOND)
+		WHERE id IN (`+placeholders(len(batch))+`)
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	return batch, tx.Commit()
+}
+
+// startPublish starts the producer span of an outbox row, continuing the
+// trace of the request that stored it, and injects it into m's headers so
+// review-task continues it in turn. Rows without a trace get a no-op span.
+func (r *OutboxRelay) startPublish(ctx context.Context, it outboxRow, m *kafka.Message) trace.Span {
+	carrier := propagation.MapCarrier{}
+	if it.traceContext == "" || json.Unmarshal([]byte(it.traceContext), &carrier) != nil {
+		return trace.SpanFromContext(ctx)
+	}
+	ctx = otel.GetTextMapPropagator().Extract(ctx, carrier)
+	ctx, span := r.tracer.Start(ctx, r.data.Kafka.Topic+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingDestinationName(r.data.Kafka.Topic),
+			semconv.MessagingKafkaMessageKey(string(m.Key)),
+			attribute.String("review.event.type", it.op),
+			attribute.Int("review.outbox.attempts", it.attempts),
+		),
+	)
+	otel.GetTextMapPropagator().Inject(ctx, telemetry.HeaderCarrier{Headers: &m.Headers})
+	return span
+}
+
+func relayBackoff(attempts int) time.Duration {
+	d := time.Second
+	for i := 1; i < attempts && d < maxRelayBackoff; i++ {
+		d *= 2
+	}
+	if d > maxRelayBackoff {
+		d = maxRelayBackoff
+	}
+	return d
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
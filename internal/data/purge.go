@@ -0,0 +1,156 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	eventsv1 "review-service/api/review/events/v1"
+	"review-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultPurgeRetention = 30 * 24 * time.Hour
+	defaultPurgeInterval  = time.Hour
+	defaultPurgeBatchSize = 100
+)
+
+// ReviewPurger permanently removes reviews that have been soft-deleted for
+// longer than the retention period, together with their replies. The audit
+// history is kept. It runs as a kratos transport.Server.
+type ReviewPurger struct {
+	repo      *reviewRepo
+	retention time.Duration
+	interval  time.Duration
+	batchSize int
+	log       *log.Helper
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewReviewPurger creates the purge job from c.Purge.
+func NewReviewPurger(d *Data, c *conf.Data, logger log.Logger) *ReviewPurger {
+	p := &ReviewPurger{
+		repo:      &reviewRepo{data: d, log: log.NewHelper(logger)},
+		retention: defaultPurgeRetention,
+		interval:  defaultPurgeInterval,
+		batchSize: defaultPurgeBatchSize,
+		log:       log.NewHelper(logger),
+	}
+	if pc := c.GetPurge(); pc != nil {
+		if pc.Retention != nil && pc.Retention.AsDuration() > 0 {
+			p.retention = pc.Retention.AsDuration()
+		}
+		if pc.Interval != nil && pc.Interval.AsDuration() > 0 {
+			p.interval = pc.Interval.AsDuration()
+		}
+		if pc.BatchSize > 0 {
+			p.batchSize = int(pc.BatchSize)
+		}
+	}
+	return p
+}
+
+// Start runs the purge every interval until Stop is called.
+func (p *ReviewPurger) Start(ctx context.Context) error {
+	ctx, p.cancel = context.WithCancel(ctx)
+	p.done = make(chan struct{})
+	go p.run(ctx)
+	return nil
+}
+
+// Stop waits for the in-flight batch to finish.
+func (p *ReviewPurger) Stop(ctx context.Context) error {
+	if p.cancel == nil {
+		return nil
+	}
+	p.cancel()
+	select {
+	case <-p.done:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+func (p *ReviewPurger) run(ctx context.Context) {
+	defer close(p.done)
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		total := 0
+		for {
+			n, err := p.purgeBatch(ctx)
+			if err != nil && ctx.Err() == nil {
+				p.log.Errorf("review purge: %v", err)
+			}
+			total += n
+			if err != nil || n < p.batchSize {
+				break
+			}
+		}
+		if total > 0 {
+			p.log.Infof("review purge: removed %d review(s) deleted more than %s ago", total, p.retention)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeBatch removes up to batchSize expired reviews in one transaction,
+// enqueueing a REVIEW_PURGED event with the final snapshot of each. SKIP
+// LOCKED lets several instances run the job at once.
+func (p *ReviewPurger) purgeBatch(ctx context.Context) (int, error) {
+	var ids []uint64
+	err := p.repo.data.InTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, `
+			SELECT id FROM reviews
+			WHERE deleted_at IS NOT NULL AND deleted_at < DATE_SUB(CURRENT_TIMESTAMP, INTERVAL ? SECOND)
+			ORDER BY deleted_at ASC LIMIT ? FOR UPDATE SKIP LOCKED
+		`, int64(p.retention/time.Second), p.batchSize)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			var id uint64
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return err
+			}
+			ids = append(ids, id)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		for _, id := range ids {
+			// the purge event carries the version the purge creates
+			if _, err := tx.ExecContext(ctx, `UPDATE reviews SET version = version + 1 WHERE id = ?`, id); err != nil {
+				return err
+			}
+			if err := p.repo.enqueueSnapshot(ctx, tx, eventsv1.EventType_REVIEW_PURGED, id); err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx, `DELETE FROM review_replies WHERE review_id = ?`, id); err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx, `DELETE FROM reviews WHERE id = ?`, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, id := range ids {
+		_ = p.repo.invalidate(ctx, id)
+		p.repo.syncBleve(ctx, id)
+	}
+	return len(ids), nil
+}
@@ -1,17 +1,17 @@
 package data
 
 import (
-    "bytes"
     "context"
     "database/sql"
     "encoding/json"
     "fmt"
     "time"
 
+    eventsv1 "review-service/api/review/events/v1"
     "review-service/internal/biz"
 
     "github.com/go-kratos/kratos/v2/log"
-    kafka "github.com/segmentio/kafka-go"
+    "google.golang.org/protobuf/proto"
 )
 
 type reviewRepo struct {
@@ -27,19 +27,29 @@ func NewReviewRepo(data *Data, logger log.Logger) biz.ReviewRepo {
 }
 
 func (r *reviewRepo) Create(ctx context.Context, in *biz.Review) (uint64, error) {
-    res, err := r.data.DB.ExecContext(ctx, `
-        INSERT INTO reviews (user_id, subject, content, rating, status)
-        VALUES (?, ?, ?, ?, 'PENDING')
-    `, in.UserID, in.Subject, in.Content, in.Rating)
+    var id uint64
+    err := r.data.InTx(ctx, func(tx *sql.Tx) error {
+        res, err := tx.ExecContext(ctx, `
+            INSERT INTO reviews (user_id, subject, content, rating, status)
+            VALUES (?, ?, ?, ?, 'PENDING')
+        `, in.UserID, in.Subject, in.Content, in.Rating)
+        if err != nil {
+            return err
+        }
+        lastID, err := res.LastInsertId()
+        if err != nil {
+            return err
+        }
+        id = uint64(lastID)
+        return r.enqueueSnapshot(ctx, tx, eventsv1.EventType_REVIEW_CREATED, id)
+    })
     if err != nil {
-        return 0, err
+        return 0, storageError(ctx, r.log, err)
     }
-    id, _ := res.LastInsertId()
     // invalidate cache
-    _ = r.invalidate(ctx, uint64(id))
-    // publish event
-    r.publish(ctx, "create", &biz.Review{ID: uint64(id), UserID: in.UserID, Subject: in.Subject, Content: in.Content, Rating: in.Rating, Status: "PENDING"})
-    return uint64(id), nil
+    _ = r.invalidate(ctx, id)
+    r.syncBleve(ctx, id)
+    return id, nil
 }
 
 func (r *reviewRepo) Get(ctx context.Context, id uint64) (*biz.Review, error) {
@@ -47,201 +57,204 @@ func (r *reviewRepo) Get(ctx context.Context, id uint64) (*biz.Review, error) {
     key := r.cacheKey(id)
     if r.data.RDB != nil {
         if s, err := r.data.RDB.Get(ctx, key).Result(); err == nil && len(s) > 0 {
-            var out biz.Review
+            var out reviewJSON
             if json.Unmarshal([]byte(s), &out) == nil {
-                return &out, nil
+                cacheRequests.WithLabelValues("hit").Inc()
+                return out.toBiz(), nil
             }
         }
+        cacheRequests.WithLabelValues("miss").Inc()
     }
 
-    row := r.data.DB.QueryRowContext(ctx, `
-        SELECT id, user_id, subject, content, rating, status FROM reviews WHERE id = ?
-    `, id)
-    var out biz.Review
-    var iid uint64
-    var uid uint64
-    var subject, content, status string
-    var rating int32
-    if err := row.Scan(&iid, &uid, &subject, &content, &rating, &status); err != nil {
-        if err == sql.ErrNoRows {
-            return nil, biz.ErrReviewNotFound
-        }
-        return nil, err
+    out, err := loadReview(ctx, r.data.DB, id)
+    if err != nil {
+        return nil, storageError(ctx, r.log, err)
     }
-    out.ID = iid
-    out.UserID = uid
-    out.Subject = subject
-    out.Content = content
-    out.Rating = rating
-    out.Status = status
 
     // set cache
     if r.data.RDB != nil {
-        if b, err := json.Marshal(out); err == nil {
+        if b, err := json.Marshal(toReviewJSON(out)); err == nil {
             _ = r.data.RDB.Set(ctx, key, string(b), 5*time.Minute).Err()
         }
     }
-    return &out, nil
+    return out, nil
 }
 
-func (r *reviewRepo) Update(ctx context.Context, in *biz.Review) error {
-    _, err := r.data.DB.ExecContext(ctx, `
-        UPDATE reviews
-        SET subject = ?, content = ?, rating = ?
-        WHERE id = ?
-    `, in.Subject, in.Content, in.Rating, in.ID)
+// Update writes the given fields of in; the other columns are left alone.
+func (r *reviewRepo) Update(ctx context.Context, in *biz.Review, fields []string, ch *biz.StatusChange) error {
+    set := ""
+    var args []any
+    for _, f := range fields {
+        switch f {
+        case biz.FieldSubject:
+            set, args = set+"subject = ?, ", append(args, in.Subject)
+        case biz.FieldContent:
+            set, args = set+"content = ?, ", append(args, in.Content)
+        case biz.FieldRating:
+            set, args = set+"rating = ?, ", append(args, in.Rating)
+        default:
+            return biz.ErrInvalidUpdateMask
+        }
+    }
+    err := r.data.InTx(ctx, func(tx *sql.Tx) error {
+        // MySQL assigns left to right: compare the old status before overwriting it
+        res, err := tx.ExecContext(ctx, `
+            UPDATE reviews
+            SET status_changed_by = IF(status = ?, status_changed_by, ?),
+                status_changed_at = IF(status = ?, status_changed_at, CURRENT_TIMESTAMP),
+                `+set+`status = ?, version = version + 1
+            WHERE id = ? AND status = ? AND (? = 0 OR version = ?) AND deleted_at IS NULL
+        `, append(append([]any{ch.To, ch.By, ch.To}, args...), ch.To, in.ID, ch.From, ch.Version, ch.Version)...)
+        if err != nil {
+            return err
+        }
+        if err := checkStatusApplied(ctx, tx, res, ch); err != nil {
+            return err
+        }
+        if ch.From != ch.To {
+            if err := insertAuditLog(ctx, tx, ch); err != nil {
+                return err
+            }
+        }
+        return r.enqueueSnapshot(ctx, tx, eventsv1.EventType_REVIEW_UPDATED, in.ID, fields...)
+    })
     if err != nil {
-        return err
+        return storageError(ctx, r.log, err)
     }
     // invalidate cache
     _ = r.invalidate(ctx, in.ID)
-    // publish event
-    r.publish(ctx, "update", in)
+    r.syncBleve(ctx, in.ID)
     return nil
 }
 
-func (r *reviewRepo) Delete(ctx context.Context, id uint64) error {
-    _, err := r.data.DB.ExecContext(ctx, `DELETE FROM reviews WHERE id = ?`, id)
+// Delete soft-deletes a review; ReviewPurger removes it for good after the
+// retention period. Deleting a deleted review is a no-op.
+func (r *reviewRepo) Delete(ctx context.Context, ch *biz.StatusChange) error {
+    id := ch.ReviewID
+    err := r.data.InTx(ctx, func(tx *sql.Tx) error {
+        res, err := tx.ExecContext(ctx, `
+            UPDATE reviews SET deleted_at = CURRENT_TIMESTAMP, deleted_by = ?, version = version + 1
+            WHERE id = ? AND deleted_at IS NULL
+        `, ch.By, id)
+        if err != nil {
+            return err
+        }
+        if n, err := res.RowsAffected(); err != nil || n == 0 {
+            return err
+        }
+        if err := insertAuditLog(ctx, tx, ch); err != nil {
+            return err
+        }
+        return r.enqueueSnapshot(ctx, tx, eventsv1.EventType_REVIEW_DELETED, id)
+    })
     if err != nil {
-        return err
+        return storageError(ctx, r.log, err)
     }
     _ = r.invalidate(ctx, id)
-    r.publish(ctx, "delete", &biz.Review{ID: id})
+    r.syncBleve(ctx, id)
     return nil
 }
 
-func (r *reviewRepo) List(ctx context.Context, in *biz.ReviewQuery) ([]*biz.Review, int64, error) {
-    // Prefer Elasticsearch when available
-    if r.data.ES != nil && r.data.ESIndex != "" {
-        // Build ES query
-        must := make([]map[string]any, 0)
-        filter := make([]map[string]any, 0)
-        if in.Q != "" {
-            must = append(must, map[string]any{
-                "multi_match": map[string]any{
-                    "query":    in.Q,
-                    "fields":   []string{"subject^2", "content"},
-                    "operator": "and",
-                },
-            })
-        }
-        if in.UserID != 0 {
-            filter = append(filter, map[string]any{"term": map[string]any{"user_id": in.UserID}})
+// Restore undoes Delete for a review that has not been purged yet.
+func (r *reviewRepo) Restore(ctx context.Context, id, by uint64) error {
+    err := r.data.InTx(ctx, func(tx *sql.Tx) error {
+        rev, err := loadReviewWithDeleted(ctx, tx, id)
+        if err != nil {
+            return err
         }
-        if in.RatingMin != 0 || in.RatingMax != 0 {
-            rangeBody := map[string]any{}
-            if in.RatingMin != 0 { rangeBody["gte"] = in.RatingMin }
-            if in.RatingMax != 0 { rangeBody["lte"] = in.RatingMax }
-            filter = append(filter, map[string]any{"range": map[string]any{"rating": rangeBody}})
+        if rev.DeletedAt == 0 {
+            return biz.ErrReviewNotDeleted
         }
-        body := map[string]any{
-            "track_total_hits": true,
-            "from":             int((in.Page-1)*in.PageSize),
-            "size":             int(in.PageSize),
-            "query": map[string]any{"bool": map[string]any{
-                "must":   must,
-                "filter": filter,
-            }},
+        res, err := tx.ExecContext(ctx, `
+            UPDATE reviews SET deleted_at = NULL, deleted_by = 0, version = version + 1
+            WHERE id = ? AND deleted_at IS NOT NULL
+        `, id)
+        if err != nil {
+            return err
         }
-        // sorting
-        switch in.Sort {
-        case "rating":
-            body["sort"] = []map[string]any{{"rating": map[string]any{"order": in.Order}}}
-        case "ts":
-            body["sort"] = []map[string]any{{"ts": map[string]any{"order": in.Order}}}
-        default:
-            // relevance: do not set sort
+        if n, err := res.RowsAffected(); err != nil {
+            return err
+        } else if n == 0 {
+            return biz.ErrReviewNotDeleted
         }
-        // execute search
-        b, _ := json.Marshal(body)
-        res, err := r.data.ES.Search(r.data.ES.Search.WithIndex(r.data.ESIndex), r.data.ES.Search.WithBody(bytes.NewReader(b)))
-        if err != nil {
-            r.log.WithContext(ctx).Errorf("es search error: %v", err)
-        } else {
-            defer res.Body.Close()
-            var parsed struct {
-                Hits struct {
-                    Total struct{ Value int64 `json:"value"` } `json:"total"`
-                    Hits []struct {
-                        ID     string         `json:"_id"`
-                        Source map[string]any `json:"_source"`
-                    } `json:"hits"`
-                } `json:"hits"`
-            }
-            if err := json.NewDecoder(res.Body).Decode(&parsed); err == nil {
-                out := make([]*biz.Review, 0, len(parsed.Hits.Hits))
-                for _, h := range parsed.Hits.Hits {
-                    src := h.Source
-                    var item biz.Review
-                    // id may be numeric or string in _source; prefer _id
-                    if v, ok := src["user_id"].(float64); ok { item.UserID = uint64(v) }
-                    if v, ok := src["subject"].(string); ok { item.Subject = v }
-                    if v, ok := src["content"].(string); ok { item.Content = v }
-                    if v, ok := src["rating"].(float64); ok { item.Rating = int32(v) }
-                    // parse id from _id
-                    var iid uint64
-                    if _, err := fmt.Sscanf(h.ID, "%d", &iid); err == nil { item.ID = iid }
-                    out = append(out, &item)
-                }
-                return out, parsed.Hits.Total.Value, nil
-            }
+        ch := &biz.StatusChange{ReviewID: id, From: rev.Status, To: rev.Status, Action: biz.ActionRestore, By: by}
+        if err := insertAuditLog(ctx, tx, ch); err != nil {
+            return err
         }
-        // fall through to DB if ES errors
+        return r.enqueueSnapshot(ctx, tx, eventsv1.EventType_REVIEW_RESTORED, id)
+    })
+    if err != nil {
+        return storageError(ctx, r.log, err)
     }
+    _ = r.invalidate(ctx, id)
+    r.syncBleve(ctx, id)
+    return nil
+}
 
-    // Fallback: MySQL pagination
-    offset := (in.Page - 1) * in.PageSize
-    var total int64
-    if err := r.data.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM reviews`).Scan(&total); err != nil {
-        return nil, 0, err
-    }
-    rows, err := r.data.DB.QueryContext(ctx, `
-        SELECT id, user_id, subject, content, rating, status
-        FROM reviews
-        ORDER BY id DESC
-        LIMIT ? OFFSET ?
-    `, in.PageSize, offset)
-    if err != nil { return nil, 0, err }
-    defer rows.Close()
-    var list []*biz.Review
-    for rows.Next() {
-        var out biz.Review
-        var iid, uid uint64
-        var subject, content, status string
-        var rating int32
-        if err := rows.Scan(&iid, &uid, &subject, &content, &rating, &status); err != nil { return nil, 0, err }
-        out.ID = iid; out.UserID = uid; out.Subject = subject; out.Content = content; out.Rating = rating; out.Status = status
-        list = append(list, &out)
-    }
-    if err := rows.Err(); err != nil { return nil, 0, err }
-    return list, total, nil
+func (r *reviewRepo) Audit(ctx context.Context, ch *biz.StatusChange) error {
+    err := r.data.InTx(ctx, func(tx *sql.Tx) error {
+        res, err := tx.ExecContext(ctx, `
+            UPDATE reviews
+            SET status = ?, audit_reason = ?, audit_by = ?, audit_at = CURRENT_TIMESTAMP,
+                status_changed_by = ?, status_changed_at = CURRENT_TIMESTAMP, version = version + 1
+            WHERE id = ? AND status = ? AND (? = 0 OR version = ?) AND deleted_at IS NULL
+        `, ch.To, ch.Reason, ch.By, ch.By, ch.ReviewID, ch.From, ch.Version, ch.Version)
+        if err != nil { return err }
+        if err := checkStatusApplied(ctx, tx, res, ch); err != nil { return err }
+        if err := insertAuditLog(ctx, tx, ch); err != nil { return err }
+        return r.enqueueSnapshot(ctx, tx, eventsv1.EventType_REVIEW_AUDITED, ch.ReviewID)
+    })
+    if err != nil { return storageError(ctx, r.log, err) }
+    _ = r.invalidate(ctx, ch.ReviewID)
+    r.syncBleve(ctx, ch.ReviewID)
+    return nil
 }
 
-func (r *reviewRepo) Audit(ctx context.Context, id uint64, decision string, reason string, operatorID uint64) error {
-    var status string
-    switch decision {
-    case "APPROVE":
-        status = "APPROVED"
-    case "REJECT":
-        status = "REJECTED"
-    default:
-        return fmt.Errorf("invalid decision")
-    }
-    _, err := r.data.DB.ExecContext(ctx, `
-        UPDATE reviews SET status = ?, audit_reason = ?, audit_by = ?, audit_at = CURRENT_TIMESTAMP WHERE id = ?
-    `, status, reason, operatorID, id)
-    if err != nil { return err }
-    r.publish(ctx, "audit", &biz.Review{ID: id, Status: status})
-    _ = r.invalidate(ctx, id)
+func (r *reviewRepo) Appeal(ctx context.Context, ch *biz.StatusChange) error {
+    err := r.data.InTx(ctx, func(tx *sql.Tx) error {
+        res, err := tx.ExecContext(ctx, `
+            UPDATE reviews
+            SET status = ?, appeal_reason = ?, status_changed_by = ?, status_changed_at = CURRENT_TIMESTAMP,
+                version = version + 1
+            WHERE id = ? AND status = ? AND deleted_at IS NULL
+        `, ch.To, ch.Reason, ch.By, ch.ReviewID, ch.From)
+        if err != nil { return err }
+        if err := checkStatusApplied(ctx, tx, res, ch); err != nil { return err }
+        if err := insertAuditLog(ctx, tx, ch); err != nil { return err }
+        return r.enqueueSnapshot(ctx, tx, eventsv1.EventType_REVIEW_APPEALED, ch.ReviewID)
+    })
+    if err != nil { return storageError(ctx, r.log, err) }
+    _ = r.invalidate(ctx, ch.ReviewID)
+    r.syncBleve(ctx, ch.ReviewID)
     return nil
 }
 
 func (r *reviewRepo) AddReply(ctx context.Context, in *biz.ReviewReply) error {
-    _, err := r.data.DB.ExecContext(ctx, `
-        INSERT INTO review_replies (review_id, merchant_id, content) VALUES (?, ?, ?)
-    `, in.ReviewID, in.MerchantID, in.Content)
-    if err != nil { return err }
-    r.publish(ctx, "reply", &biz.Review{ID: in.ReviewID})
+    err := r.data.InTx(ctx, func(tx *sql.Tx) error {
+        res, err := tx.ExecContext(ctx, `
+            INSERT INTO review_replies (review_id, merchant_id, content) VALUES (?, ?, ?)
+        `, in.ReviewID, in.MerchantID, in.Content)
+        if err != nil { return err }
+        replyID, err := res.LastInsertId()
+        if err != nil { return err }
+        // reply stats are denormalized for search; a reply is not an edit, so updated_at is kept
+        res, err = tx.ExecContext(ctx, `
+            UPDATE reviews SET reply_count = reply_count + 1,
+                last_reply_at = (SELECT created_at FROM review_replies WHERE id = ?),
+                updated_at = updated_at, version = version + 1
+            WHERE id = ? AND deleted_at IS NULL
+        `, replyID, in.ReviewID)
+        if err != nil { return err }
+        if n, err := res.RowsAffected(); err != nil {
+            return err
+        } else if n == 0 {
+            return biz.ErrReviewNotFound
+        }
+        return r.enqueueSnapshot(ctx, tx, eventsv1.EventType_REVIEW_REPLIED, in.ReviewID)
+    })
+    if err != nil { return storageError(ctx, r.log, err) }
+    _ = r.invalidate(ctx, in.ReviewID)
+    r.syncBleve(ctx, in.ReviewID)
     return nil
 }
 
@@ -249,17 +262,39 @@ func (r *reviewRepo) ListReplies(ctx context.Context, reviewID uint64) ([]*biz.R
     rows, err := r.data.DB.QueryContext(ctx, `
         SELECT id, review_id, merchant_id, content, UNIX_TIMESTAMP(created_at) FROM review_replies WHERE review_id = ? ORDER BY id ASC
     `, reviewID)
-    if err != nil { return nil, err }
+    if err != nil { return nil, storageError(ctx, r.log, err) }
     defer rows.Close()
     var list []*biz.ReviewReply
     for rows.Next() {
         var it biz.ReviewReply
         var ts int64
-        if err := rows.Scan(&it.ID, &it.ReviewID, &it.MerchantID, &it.Content, &ts); err != nil { return nil, err }
+        if err := rows.Scan(&it.ID, &it.ReviewID, &it.MerchantID, &it.Content, &ts); err != nil { return nil, storageError(ctx, r.log, err) }
         it.CreatedAt = ts
         list = append(list, &it)
     }
-    if err := rows.Err(); err != nil { return nil, err }
+    if err := rows.Err(); err != nil { return nil, storageError(ctx, r.log, err) }
+    return list, nil
+}
+
+func (r *reviewRepo) ListAuditHistory(ctx context.Context, reviewID uint64) ([]*biz.AuditRecord, error) {
+    if _, err := loadReviewWithDeleted(ctx, r.data.DB, reviewID); err != nil {
+        return nil, storageError(ctx, r.log, err)
+    }
+    rows, err := r.data.DB.QueryContext(ctx, `
+        SELECT id, review_id, action, from_status, to_status, operator_id, reason, UNIX_TIMESTAMP(created_at)
+        FROM review_audit_logs WHERE review_id = ? ORDER BY id ASC
+    `, reviewID)
+    if err != nil { return nil, storageError(ctx, r.log, err) }
+    defer rows.Close()
+    var list []*biz.AuditRecord
+    for rows.Next() {
+        var it biz.AuditRecord
+        var action string
+        if err := rows.Scan(&it.ID, &it.ReviewID, &action, &it.FromStatus, &it.ToStatus, &it.OperatorID, &it.Reason, &it.CreatedAt); err != nil { return nil, storageError(ctx, r.log, err) }
+        it.Action = biz.StatusAction(action)
+        list = append(list, &it)
+    }
+    if err := rows.Err(); err != nil { return nil, storageError(ctx, r.log, err) }
     return list, nil
 }
 
@@ -268,30 +303,161 @@ func (r *reviewRepo) ListPending(ctx context.Context, page, pageSize int32) ([]*
     if pageSize <= 0 || pageSize > 100 { pageSize = 20 }
     offset := (page - 1) * pageSize
     var total int64
-    if err := r.data.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM reviews WHERE status = 'PENDING'`).Scan(&total); err != nil {
-        return nil, 0, err
+    if err := r.data.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM reviews WHERE status = 'PENDING' AND deleted_at IS NULL`).Scan(&total); err != nil {
+        return nil, 0, storageError(ctx, r.log, err)
     }
     rows, err := r.data.DB.QueryContext(ctx, `
-        SELECT id, user_id, subject, content, rating, status FROM reviews WHERE status = 'PENDING' ORDER BY id DESC LIMIT ? OFFSET ?
+        SELECT `+reviewColumns+` FROM reviews WHERE status = 'PENDING' AND deleted_at IS NULL ORDER BY id DESC LIMIT ? OFFSET ?
     `, pageSize, offset)
-    if err != nil { return nil, 0, err }
+    if err != nil { return nil, 0, storageError(ctx, r.log, err) }
+    list, err := scanReviews(rows)
+    if err != nil { return nil, 0, storageError(ctx, r.log, err) }
+    return list, total, nil
+}
+
+// checkStatusApplied verifies that a compare-and-set on reviews.status, and
+// on reviews.version when ch.Version is set, hit its row. MySQL reports 0
+// affected rows for a no-op update too, so a miss is re-checked before it is
+// treated as a concurrent change.
+func checkStatusApplied(ctx context.Context, tx *sql.Tx, res sql.Result, ch *biz.StatusChange) error {
+    if n, err := res.RowsAffected(); err != nil || n > 0 {
+        return err
+    }
+    var status string
+    var version uint64
+    err := tx.QueryRowContext(ctx, `SELECT status, version FROM reviews WHERE id = ? AND deleted_at IS NULL`, ch.ReviewID).Scan(&status, &version)
+    if err == sql.ErrNoRows {
+        return biz.ErrReviewNotFound
+    }
+    if err != nil {
+        return err
+    }
+    if ch.Version != 0 && version != ch.Version {
+        return biz.ErrVersionConflict
+    }
+    if status != ch.From {
+        return biz.ErrStatusConflict
+    }
+    return nil
+}
+
+// insertAuditLog appends a status change to review_audit_logs; rows are never updated.
+func insertAuditLog(ctx context.Context, tx *sql.Tx, ch *biz.StatusChange) error {
+    _, err := tx.ExecContext(ctx, `
+        INSERT INTO review_audit_logs (review_id, action, from_status, to_status, operator_id, reason)
+        VALUES (?, ?, ?, ?, ?, ?)
+    `, ch.ReviewID, string(ch.Action), ch.From, ch.To, ch.By, ch.Reason)
+    return err
+}
+
+// reviewColumns is the column list scanned by scanReview.
+const reviewColumns = `id, user_id, subject, content, rating, status,
+    audit_reason, audit_by, COALESCE(UNIX_TIMESTAMP(audit_at), 0),
+    status_changed_by, COALESCE(UNIX_TIMESTAMP(status_changed_at), 0),
+    reply_count, COALESCE(UNIX_TIMESTAMP(last_reply_at), 0), version,
+    COALESCE(UNIX_TIMESTAMP(deleted_at), 0), deleted_by,
+    UNIX_TIMESTAMP(created_at), UNIX_TIMESTAMP(updated_at)`
+
+type rowScanner interface {
+    Scan(dest ...any) error
+}
+
+func scanReview(row rowScanner) (*biz.Review, error) {
+    var out biz.Review
+    err := row.Scan(&out.ID, &out.UserID, &out.Subject, &out.Content, &out.Rating, &out.Status,
+        &out.AuditReason, &out.AuditBy, &out.AuditAt,
+        &out.StatusChangedBy, &out.StatusChangedAt,
+        &out.ReplyCount, &out.LastReplyAt, &out.Version,
+        &out.DeletedAt, &out.DeletedBy,
+        &out.CreatedAt, &out.UpdatedAt)
+    if err != nil {
+        return nil, err
+    }
+    return &out, nil
+}
+
+func scanReviews(rows *sql.Rows) ([]*biz.Review, error) {
     defer rows.Close()
     var list []*biz.Review
     for rows.Next() {
-        var out biz.Review
-        var iid, uid uint64
-        var subject, content, status string
-        var rating int32
-        if err := rows.Scan(&iid, &uid, &subject, &content, &rating, &status); err != nil { return nil, 0, err }
-        out.ID = iid; out.UserID = uid; out.Subject = subject; out.Content = content; out.Rating = rating; out.Status = status
-        list = append(list, &out)
+        out, err := scanReview(rows)
+        if err != nil { return nil, err }
+        list = append(list, out)
     }
-    if err := rows.Err(); err != nil { return nil, 0, err }
-    return list, total, nil
+    if err := rows.Err(); err != nil { return nil, err }
+    return list, nil
+}
+
+type queryRower interface {
+    QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
 }
 
+// loadReview reads one review from the database or a transaction, bypassing
+// the cache. Soft-deleted reviews are not found.
+func loadReview(ctx context.Context, q queryRower, id uint64) (*biz.Review, error) {
+    out, err := scanReview(q.QueryRowContext(ctx, `SELECT `+reviewColumns+` FROM reviews WHERE id = ? AND deleted_at IS NULL`, id))
+    if err == sql.ErrNoRows {
+        return nil, biz.ErrReviewNotFound
+    }
+    return out, err
+}
+
+// loadReviewWithDeleted is loadReview including soft-deleted reviews.
+func loadReviewWithDeleted(ctx context.Context, q queryRower, id uint64) (*biz.Review, error) {
+    out, err := scanReview(q.QueryRowContext(ctx, `SELECT `+reviewColumns+` FROM reviews WHERE id = ?`, id))
+    if err == sql.ErrNoRows {
+        return nil, biz.ErrReviewNotFound
+    }
+    return out, err
+}
+
+// reviewJSON is the JSON form of a review shared by the cache and event payloads.
+type reviewJSON struct {
+    ID              uint64 `json:"id"`
+    UserID          uint64 `json:"user_id"`
+    Subject         string `json:"subject"`
+    Content         string `json:"content"`
+    Rating          int32  `json:"rating"`
+    Status          string `json:"status"`
+    AuditReason     string `json:"audit_reason"`
+    AuditBy         uint64 `json:"audit_by"`
+    AuditAt         int64  `json:"audit_at"`
+    StatusChangedBy uint64 `json:"status_changed_by"`
+    StatusChangedAt int64  `json:"status_changed_at"`
+    ReplyCount      int32  `json:"reply_count"`
+    LastReplyAt     int64  `json:"last_reply_at"`
+    Version         uint64 `json:"version"`
+    DeletedAt       int64  `json:"deleted_at"`
+    DeletedBy       uint64 `json:"deleted_by"`
+    CreatedAt       int64  `json:"created_at"`
+    UpdatedAt       int64  `json:"updated_at"`
+}
+
+func toReviewJSON(in *biz.Review) *reviewJSON {
+    return &reviewJSON{
+        ID: in.ID, UserID: in.UserID, Subject: in.Subject, Content: in.Content, Rating: in.Rating, Status: in.Status,
+        AuditReason: in.AuditReason, AuditBy: in.AuditBy, AuditAt: in.AuditAt,
+        StatusChangedBy: in.StatusChangedBy, StatusChangedAt: in.StatusChangedAt,
+        ReplyCount: in.ReplyCount, LastReplyAt: in.LastReplyAt, Version: in.Version,
+        DeletedAt: in.DeletedAt, DeletedBy: in.DeletedBy,
+        CreatedAt: in.CreatedAt, UpdatedAt: in.UpdatedAt,
+    }
+}
+
+func (j *reviewJSON) toBiz() *biz.Review {
+    return &biz.Review{
+        ID: j.ID, UserID: j.UserID, Subject: j.Subject, Content: j.Content, Rating: j.Rating, Status: j.Status,
+        AuditReason: j.AuditReason, AuditBy: j.AuditBy, AuditAt: j.AuditAt,
+        StatusChangedBy: j.StatusChangedBy, StatusChangedAt: j.StatusChangedAt,
+        ReplyCount: j.ReplyCount, LastReplyAt: j.LastReplyAt, Version: j.Version,
+        DeletedAt: j.DeletedAt, DeletedBy: j.DeletedBy,
+        CreatedAt: j.CreatedAt, UpdatedAt: j.UpdatedAt,
+    }
+}
+
+// cacheKey is versioned so entries written in an older JSON layout are not read back.
 func (r *reviewRepo) cacheKey(id uint64) string {
-    return fmt.Sprintf("review:%d", id)
+    return fmt.Sprintf("review:v5:%d", id)
 }
 
 func (r *reviewRepo) invalidate(ctx context.Context, id uint64) error {
@@ -301,21 +467,23 @@ func (r *reviewRepo) invalidate(ctx context.Context, id uint64) error {
     return r.data.RDB.Del(ctx, r.cacheKey(id)).Err()
 }
 
-type reviewEvent struct {
-    Op       string      `json:"op"`
-    Payload  *biz.Review `json:"payload"`
-    Ts       int64       `json:"ts"`
-}
-
-func (r *reviewRepo) publish(ctx context.Context, op string, rev *biz.Review) {
+// enqueueSnapshot publishes the review's current row, as seen inside tx,
+// along with the fields an update changed.
+func (r *reviewRepo) enqueueSnapshot(ctx context.Context, tx *sql.Tx, typ eventsv1.EventType, id uint64, changed ...string) error {
     if r.data.Kafka == nil {
-        return
+        return nil
+    }
+    rev, err := loadReviewWithDeleted(ctx, tx, id)
+    if err != nil {
+        return err
     }
-    evt := reviewEvent{Op: op, Payload: rev, Ts: time.Now().Unix()}
-    b, err := json.Marshal(evt)
+    evt := newReviewEvent(typ, rev)
+    evt.ChangedFields = changed
+    b, err := proto.Marshal(evt)
     if err != nil {
-        r.log.WithContext(ctx).Errorf("marshal event: %v", err)
-        return
+        return fmt.Errorf("marshal event: %w", err)
     }
-    _ = r.data.Kafka.WriteMessages(ctx, kafka.Message{Value: b})
+    // OutboxRelay delivers it to Kafka after commit. Without Kafka there is
+    // no consumer, so nothing is stored.
+    return enqueueEvent(ctx, tx, rev.ID, typ.String(), b)
 }
@@ -0,0 +1,76 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Search backends selectable with data.search.backend.
+const (
+	SearchElasticsearch = "elasticsearch"
+	SearchMySQL         = "mysql"
+	SearchBleve         = "bleve"
+)
+
+// searchBackend resolves the configured backend, defaulting to Elasticsearch
+// when it is configured and to MySQL otherwise.
+func searchBackend(c *conf.Data) string {
+	if c.Search != nil && c.Search.Backend != "" {
+		return c.Search.Backend
+	}
+	if c.Elasticsearch != nil && len(c.Elasticsearch.Addresses) > 0 {
+		return SearchElasticsearch
+	}
+	return SearchMySQL
+}
+
+// NewReviewSearcher returns the configured biz.ReviewSearcher. Elasticsearch
+// falls back to MySQL when a search fails.
+func NewReviewSearcher(c *conf.Data, d *Data, logger log.Logger) (biz.ReviewSearcher, error) {
+	helper := log.NewHelper(logger)
+	s, err := newReviewSearcher(c, d, helper)
+	if err != nil {
+		return nil, err
+	}
+	return &storageErrorSearcher{ReviewSearcher: s, log: helper}, nil
+}
+
+func newReviewSearcher(c *conf.Data, d *Data, helper *log.Helper) (biz.ReviewSearcher, error) {
+	db := &mysqlSearcher{data: d}
+	switch backend := searchBackend(c); backend {
+	case SearchElasticsearch:
+		if d.ES == nil {
+			helper.Warn("search backend elasticsearch unavailable, using mysql")
+			return db, nil
+		}
+		return &fallbackSearcher{primary: &esSearcher{data: d}, fallback: db, log: helper}, nil
+	case SearchMySQL:
+		return db, nil
+	case SearchBleve:
+		return &bleveSearcher{data: d}, nil
+	default:
+		return nil, fmt.Errorf("unknown search backend %q", backend)
+	}
+}
+
+// fallbackSearcher serves degraded results from fallback when primary errors.
+type fallbackSearcher struct {
+	primary  biz.ReviewSearcher
+	fallback biz.ReviewSearcher
+	log      *log.Helper
+}
+
+func (s *fallbackSearcher) Search(ctx context.Context, in *biz.ReviewQuery) ([]*biz.Review, int64, error) {
+	list, total, err := s.primary.Search(ctx, in)
+	if err == nil {
+		return list, total, nil
+	}
+	s.log.WithContext(ctx).Errorf("search failed, falling back to mysql: %v", err)
+	searchFallbacks.Inc()
+	return s.fallback.Search(ctx, in)
+}
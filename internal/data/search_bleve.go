@@ -0,0 +1,295 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"strconv"
+
+	"review-service/internal/biz"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/query"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const defaultBlevePath = "data/reviews.bleve"
+
+// bleveDoc is the document stored in the embedded index.
+type bleveDoc struct {
+	// the document id is a string, which sorts "10" before "9"
+	ID          float64 `json:"id"`
+	UserID      float64 `json:"user_id"`
+	Subject     string  `json:"subject"`
+	Content     string  `json:"content"`
+	Rating      float64 `json:"rating"`
+	Status      string  `json:"status"`
+	AuditReason string  `json:"audit_reason"`
+	AuditBy     float64 `json:"audit_by"`
+	AuditAt     float64 `json:"audit_at"`
+	ReplyCount  float64 `json:"reply_count"`
+	LastReplyAt float64 `json:"last_reply_at"`
+	CreatedAt   float64 `json:"created_at"`
+	UpdatedAt   float64 `json:"updated_at"`
+	Version     float64 `json:"version"`
+}
+
+func newBleveDoc(in *biz.Review) *bleveDoc {
+	return &bleveDoc{
+		ID:          float64(in.ID),
+		UserID:      float64(in.UserID),
+		Subject:     in.Subject,
+		Content:     in.Content,
+		Rating:      float64(in.Rating),
+		Status:      in.Status,
+		AuditReason: in.AuditReason,
+		AuditBy:     float64(in.AuditBy),
+		AuditAt:     float64(in.AuditAt),
+		ReplyCount:  float64(in.ReplyCount),
+		LastReplyAt: float64(in.LastReplyAt),
+		CreatedAt:   float64(in.CreatedAt),
+		UpdatedAt:   float64(in.UpdatedAt),
+		Version:     float64(in.Version),
+	}
+}
+
+// bleveMapping indexes subject and content with the CJK analyzer, which
+// bigrams Chinese text and tokenizes the rest on word boundaries.
+func bleveMapping() mapping.IndexMapping {
+	text := bleve.NewTextFieldMapping()
+	text.Analyzer = cjk.AnalyzerName
+	num := bleve.NewNumericFieldMapping()
+	kw := bleve.NewKeywordFieldMapping()
+	stored := bleve.NewTextFieldMapping()
+	stored.Index = false
+
+	doc := bleve.NewDocumentMapping()
+	doc.AddFieldMappingsAt("subject", text)
+	doc.AddFieldMappingsAt("content", text)
+	doc.AddFieldMappingsAt("id", num)
+	doc.AddFieldMappingsAt("user_id", num)
+	doc.AddFieldMappingsAt("rating", num)
+	doc.AddFieldMappingsAt("status", kw)
+	doc.AddFieldMappingsAt("audit_reason", stored)
+	doc.AddFieldMappingsAt("audit_by", num)
+	doc.AddFieldMappingsAt("audit_at", num)
+	doc.AddFieldMappingsAt("reply_count", num)
+	doc.AddFieldMappingsAt("last_reply_at", num)
+	doc.AddFieldMappingsAt("created_at", num)
+	doc.AddFieldMappingsAt("updated_at", num)
+	doc.AddFieldMappingsAt("version", num)
+
+	m := bleve.NewIndexMapping()
+	m.DefaultMapping = doc
+	return m
+}
+
+// openBleve opens the index at path, creating it and backfilling it from
+// MySQL on first use. An index built before the numeric id field existed is
+// rebuilt, since searches sort on it.
+func openBleve(ctx context.Context, path string, db *sql.DB, helper *log.Helper) (bleve.Index, error) {
+	if path == "" {
+		path = defaultBlevePath
+	}
+	if _, err := os.Stat(path); err == nil {
+		idx, err := bleve.Open(path)
+		if err != nil || hasBleveField(idx, "id") {
+			return idx, err
+		}
+		helper.Warnf("bleve index at %s has no numeric id field, rebuilding it", path)
+		_ = idx.Close()
+		if err := os.RemoveAll(path); err != nil {
+			return nil, err
+		}
+	}
+	idx, err := bleve.New(path, bleveMapping())
+	if err != nil {
+		return nil, err
+	}
+	n, err := backfillBleve(ctx, idx, db)
+	if err != nil {
+		_ = idx.Close()
+		_ = os.RemoveAll(path)
+		return nil, err
+	}
+	helper.Infof("bleve index created at %s with %d review(s)", path, n)
+	return idx, nil
+}
+
+func backfillBleve(ctx context.Context, idx bleve.Index, db *sql.DB) (int, error) {
+	rows, err := db.QueryContext(ctx, `SELECT `+reviewColumns+` FROM reviews WHERE deleted_at IS NULL`)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	n := 0
+	batch := idx.NewBatch()
+	for rows.Next() {
+		rev, err := scanReview(rows)
+		if err != nil {
+			return n, err
+		}
+		if err := batch.Index(bleveID(rev.ID), newBleveDoc(rev)); err != nil {
+			return n, err
+		}
+		if n++; batch.Size() >= 500 {
+			if err := idx.Batch(batch); err != nil {
+				return n, err
+			}
+			batch.Reset()
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return n, err
+	}
+	return n, idx.Batch(batch)
+}
+
+func hasBleveField(idx bleve.Index, field string) bool {
+	m, ok := idx.Mapping().(*mapping.IndexMappingImpl)
+	if !ok || m.DefaultMapping == nil {
+		return false
+	}
+	_, ok = m.DefaultMapping.Properties[field]
+	return ok
+}
+
+func bleveID(id uint64) string { return strconv.FormatUint(id, 10) }
+
+// bleveSearcher serves searches from the embedded index; reviewRepo keeps
+// it in sync after every committed write.
+type bleveSearcher struct {
+	data *Data
+}
+
+func (s *bleveSearcher) Search(ctx context.Context, in *biz.ReviewQuery) ([]*biz.Review, int64, error) {
+	var must []query.Query
+	if in.Q != "" {
+		subject := bleve.NewMatchQuery(in.Q)
+		subject.SetField("subject")
+		subject.SetOperator(query.MatchQueryOperatorAnd)
+		subject.SetBoost(2)
+		content := bleve.NewMatchQuery(in.Q)
+		content.SetField("content")
+		content.SetOperator(query.MatchQueryOperatorAnd)
+		must = append(must, bleve.NewDisjunctionQuery(subject, content))
+	}
+	if in.UserID != 0 {
+		must = append(must, numericEq("user_id", float64(in.UserID)))
+	}
+	if in.RatingMin != 0 || in.RatingMax != 0 {
+		var lo, hi *float64
+		if in.RatingMin != 0 {
+			v := float64(in.RatingMin)
+			lo = &v
+		}
+		if in.RatingMax != 0 {
+			v := float64(in.RatingMax)
+			hi = &v
+		}
+		incl := true
+		rq := bleve.NewNumericRangeInclusiveQuery(lo, hi, &incl, &incl)
+		rq.SetField("rating")
+		must = append(must, rq)
+	}
+	if len(in.Statuses) > 0 || len(in.OwnerStatuses) > 0 {
+		visible := bleve.NewDisjunctionQuery()
+		if len(in.Statuses) > 0 {
+			visible.AddQuery(statusIn(in.Statuses))
+		}
+		if in.OwnerID != 0 && len(in.OwnerStatuses) > 0 {
+			visible.AddQuery(bleve.NewConjunctionQuery(numericEq("user_id", float64(in.OwnerID)), statusIn(in.OwnerStatuses)))
+		}
+		must = append(must, visible)
+	}
+	if in.HasReply != nil {
+		one := 1.0
+		rq := bleve.NewNumericRangeQuery(&one, nil)
+		rq.SetField("reply_count")
+		if *in.HasReply {
+			must = append(must, rq)
+		} else {
+			nq := bleve.NewBooleanQuery()
+			nq.AddMust(bleve.NewMatchAllQuery())
+			nq.AddMustNot(rq)
+			must = append(must, nq)
+		}
+	}
+	var q query.Query = bleve.NewMatchAllQuery()
+	if len(must) > 0 {
+		q = bleve.NewConjunctionQuery(must...)
+	}
+
+	req := bleve.NewSearchRequestOptions(q, int(in.PageSize), int((in.Page-1)*in.PageSize), false)
+	req.Fields = []string{"*"}
+	// ties are broken by id, as on the other backends, so pages line up
+	desc := in.Order != "asc"
+	switch in.Sort {
+	case "rating":
+		req.SortBy([]string{sortField("rating", desc), sortField("id", desc)})
+	case "ts":
+		req.SortBy([]string{sortField("created_at", desc), sortField("id", desc)})
+	default:
+		if in.Q == "" {
+			req.SortBy([]string{"-id"})
+		} else {
+			req.SortBy([]string{"-_score", "-id"})
+		}
+	}
+	res, err := s.data.Bleve.SearchInContext(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+	out := make([]*biz.Review, 0, len(res.Hits))
+	for _, h := range res.Hits {
+		item := reviewFromSource(h.Fields)
+		item.ID, _ = strconv.ParseUint(h.ID, 10, 64)
+		out = append(out, item)
+	}
+	return out, int64(res.Total), nil
+}
+
+func numericEq(field string, v float64) query.Query {
+	incl := true
+	q := bleve.NewNumericRangeInclusiveQuery(&v, &v, &incl, &incl)
+	q.SetField(field)
+	return q
+}
+
+func sortField(field string, desc bool) string {
+	if desc {
+		return "-" + field
+	}
+	return field
+}
+
+// syncBleve refreshes one review in the embedded index after a committed
+// write, removing it once the row is gone or soft-deleted. Failures are logged: the index
+// is rebuilt from MySQL by deleting its directory.
+func (r *reviewRepo) syncBleve(ctx context.Context, id uint64) {
+	if r.data.Bleve == nil {
+		return
+	}
+	rev, err := loadReview(ctx, r.data.DB, id)
+	switch {
+	case err == biz.ErrReviewNotFound:
+		err = r.data.Bleve.Delete(bleveID(id))
+	case err == nil:
+		err = r.data.Bleve.Index(bleveID(id), newBleveDoc(rev))
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("bleve sync review %d: %v", id, err)
+	}
+}
+
+func statusIn(statuses []string) query.Query {
+	q := bleve.NewDisjunctionQuery()
+	for _, st := range statuses {
+		tq := bleve.NewTermQuery(st)
+		tq.SetField("status")
+		q.AddQuery(tq)
+	}
+	return q
+}
@@ -0,0 +1,58 @@
+package data
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"review-service/internal/biz"
+
+	"github.com/blevesearch/bleve/v2"
+)
+
+func TestBleveSearchSortsByNumericID(t *testing.T) {
+	idx, err := bleve.NewMemOnly(bleveMapping())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = idx.Close() })
+	// ids 1-12 share a rating and a creation time, so every order falls back
+	// to the id tiebreaker; as strings "10" would sort before "9"
+	for id := uint64(1); id <= 12; id++ {
+		rev := &biz.Review{ID: id, UserID: 100, Subject: "电池", Content: "续航", Rating: 4, Status: biz.StatusApproved, CreatedAt: 1700000000, Version: 1}
+		if err := idx.Index(bleveID(id), newBleveDoc(rev)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !hasBleveField(idx, "id") {
+		t.Fatal("mapping has no id field")
+	}
+
+	s := &bleveSearcher{data: &Data{Bleve: idx}}
+	tests := []struct {
+		name  string
+		query biz.ReviewQuery
+		want  []uint64
+	}{
+		{name: "relevance without q", query: biz.ReviewQuery{Page: 1, PageSize: 4, Sort: "relevance", Order: "desc"}, want: []uint64{12, 11, 10, 9}},
+		{name: "relevance with q", query: biz.ReviewQuery{Page: 1, PageSize: 4, Q: "电池", Sort: "relevance", Order: "desc"}, want: []uint64{12, 11, 10, 9}},
+		{name: "ts desc second page", query: biz.ReviewQuery{Page: 2, PageSize: 4, Sort: "ts", Order: "desc"}, want: []uint64{8, 7, 6, 5}},
+		{name: "ts asc last page", query: biz.ReviewQuery{Page: 3, PageSize: 4, Sort: "ts", Order: "asc"}, want: []uint64{9, 10, 11, 12}},
+		{name: "rating asc", query: biz.ReviewQuery{Page: 1, PageSize: 12, Sort: "rating", Order: "asc"}, want: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, total, err := s.Search(context.Background(), &tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make([]uint64, 0, len(list))
+			for _, r := range list {
+				got = append(got, r.ID)
+			}
+			if total != 12 || !slices.Equal(got, tt.want) {
+				t.Errorf("got %v (total %d), want %v (total 12)", got, total, tt.want)
+			}
+		})
+	}
+}
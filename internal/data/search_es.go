@@ -0,0 +1,177 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"review-service/internal/biz"
+)
+
+// esSearcher queries the index maintained by cmd/review-task.
+type esSearcher struct {
+	data *Data
+}
+
+func (s *esSearcher) Search(ctx context.Context, in *biz.ReviewQuery) ([]*biz.Review, int64, error) {
+	// Build ES query
+	must := make([]map[string]any, 0)
+	filter := make([]map[string]any, 0)
+	if in.Q != "" {
+		must = append(must, map[string]any{
+			"multi_match": map[string]any{
+				"query":    in.Q,
+				"fields":   []string{"subject^2", "content"},
+				"operator": "and",
+			},
+		})
+	}
+	if in.UserID != 0 {
+		filter = append(filter, map[string]any{"term": map[string]any{"user_id": in.UserID}})
+	}
+	if in.RatingMin != 0 || in.RatingMax != 0 {
+		rangeBody := map[string]any{}
+		if in.RatingMin != 0 {
+			rangeBody["gte"] = in.RatingMin
+		}
+		if in.RatingMax != 0 {
+			rangeBody["lte"] = in.RatingMax
+		}
+		filter = append(filter, map[string]any{"range": map[string]any{"rating": rangeBody}})
+	}
+	if len(in.Statuses) > 0 || len(in.OwnerStatuses) > 0 {
+		should := make([]map[string]any, 0, 2)
+		if len(in.Statuses) > 0 {
+			should = append(should, map[string]any{"terms": map[string]any{"status": in.Statuses}})
+		}
+		if in.OwnerID != 0 && len(in.OwnerStatuses) > 0 {
+			should = append(should, map[string]any{"bool": map[string]any{"filter": []map[string]any{
+				{"term": map[string]any{"user_id": in.OwnerID}},
+				{"terms": map[string]any{"status": in.OwnerStatuses}},
+			}}})
+		}
+		filter = append(filter, map[string]any{"bool": map[string]any{"should": should, "minimum_should_match": 1}})
+	}
+	mustNot := make([]map[string]any, 0)
+	if in.HasReply != nil {
+		replied := map[string]any{"range": map[string]any{"reply_count": map[string]any{"gte": 1}}}
+		if *in.HasReply {
+			filter = append(filter, replied)
+		} else {
+			mustNot = append(mustNot, replied)
+		}
+	}
+	body := map[string]any{
+		"track_total_hits": true,
+		// the external version is the review version, for documents indexed
+		// before it was part of _source
+		"version": true,
+		"from":    int((in.Page - 1) * in.PageSize),
+		"size":    int(in.PageSize),
+		"query": map[string]any{"bool": map[string]any{
+			"must":     must,
+			"filter":   filter,
+			"must_not": mustNot,
+		}},
+	}
+	// sorting; ties are broken by id, in the same order as mysqlSearcher, so
+	// pages do not depend on the backend
+	switch in.Sort {
+	case "rating":
+		body["sort"] = []map[string]any{{"rating": map[string]any{"order": in.Order}}, {"id": map[string]any{"order": in.Order}}}
+	case "ts":
+		// creation time; the document's ts field is the time of its last change
+		body["sort"] = []map[string]any{{"created_at": map[string]any{"order": in.Order}}, {"id": map[string]any{"order": in.Order}}}
+	default:
+		// relevance, newest first without Q
+		body["sort"] = []map[string]any{{"_score": map[string]any{"order": "desc"}}, {"id": map[string]any{"order": "desc"}}}
+	}
+	// execute search
+	b, _ := json.Marshal(body)
+	res, err := s.data.ES.Search(
+		s.data.ES.Search.WithContext(ctx),
+		s.data.ES.Search.WithIndex(s.data.ESIndex),
+		s.data.ES.Search.WithBody(bytes.NewReader(b)),
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return nil, 0, fmt.Errorf("es search: %s", res.Status())
+	}
+	var parsed struct {
+		Hits struct {
+			Total struct {
+				Value int64 `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				ID      string         `json:"_id"`
+				Version uint64         `json:"_version"`
+				Source  map[string]any `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return nil, 0, err
+	}
+	out := make([]*biz.Review, 0, len(parsed.Hits.Hits))
+	for _, h := range parsed.Hits.Hits {
+		item := reviewFromSource(h.Source)
+		if item.Version == 0 {
+			item.Version = h.Version
+		}
+		// id may be numeric or string in _source; prefer _id
+		var iid uint64
+		if _, err := fmt.Sscanf(h.ID, "%d", &iid); err == nil {
+			item.ID = iid
+		}
+		out = append(out, item)
+	}
+	return out, parsed.Hits.Total.Value, nil
+}
+
+func reviewFromSource(src map[string]any) *biz.Review {
+	var item biz.Review
+	if v, ok := src["user_id"].(float64); ok {
+		item.UserID = uint64(v)
+	}
+	if v, ok := src["subject"].(string); ok {
+		item.Subject = v
+	}
+	if v, ok := src["content"].(string); ok {
+		item.Content = v
+	}
+	if v, ok := src["rating"].(float64); ok {
+		item.Rating = int32(v)
+	}
+	if v, ok := src["status"].(string); ok {
+		item.Status = v
+	}
+	if v, ok := src["audit_reason"].(string); ok {
+		item.AuditReason = v
+	}
+	if v, ok := src["audit_by"].(float64); ok {
+		item.AuditBy = uint64(v)
+	}
+	if v, ok := src["audit_at"].(float64); ok {
+		item.AuditAt = int64(v)
+	}
+	if v, ok := src["reply_count"].(float64); ok {
+		item.ReplyCount = int32(v)
+	}
+	if v, ok := src["last_reply_at"].(float64); ok {
+		item.LastReplyAt = int64(v)
+	}
+	if v, ok := src["created_at"].(float64); ok {
+		item.CreatedAt = int64(v)
+	}
+	if v, ok := src["updated_at"].(float64); ok {
+		item.UpdatedAt = int64(v)
+	}
+	if v, ok := src["version"].(float64); ok {
+		item.Version = uint64(v)
+	}
+	return &item
+}
@@ -0,0 +1,43 @@
+package data
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"review-service/internal/biz"
+)
+
+func TestReviewDocumentRoundTrip(t *testing.T) {
+	in := &biz.Review{
+		ID:          12,
+		UserID:      34,
+		Subject:     "电池很耐用",
+		Content:     "续航一整天",
+		Rating:      5,
+		Status:      biz.StatusApproved,
+		AuditReason: "ok",
+		AuditBy:     56,
+		AuditAt:     1700000100,
+		ReplyCount:  2,
+		LastReplyAt: 1700000200,
+		CreatedAt:   1700000000,
+		UpdatedAt:   1700000300,
+		Version:     7,
+	}
+	// _source comes back as decoded JSON, with every number a float64
+	b, err := json.Marshal(ReviewDocument(in, 1700000400))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var src map[string]any
+	if err := json.Unmarshal(b, &src); err != nil {
+		t.Fatal(err)
+	}
+	got := reviewFromSource(src)
+	want := *in
+	want.ID = 0 // taken from _id
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("reviewFromSource(ReviewDocument(r)) = %+v, want %+v", *got, want)
+	}
+}
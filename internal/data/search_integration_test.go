@@ -0,0 +1,212 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+
+	esv8 "github.com/elastic/go-elasticsearch/v8"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// The search backends are tested against live servers:
+//
+//	REVIEW_TEST_MYSQL_DSN=root:pass@tcp(127.0.0.1:3306)/review_test \
+//	REVIEW_TEST_ES_ADDRESSES=http://127.0.0.1:9200 \
+//	go test -tags integration ./internal/data/
+//
+// The MySQL database is migrated and its reviews are replaced; the ES index
+// is created under a unique name and deleted afterwards.
+
+// searchFixtures are the reviews every backend is loaded with. created_at
+// does not follow id, so creation-time and id order differ.
+var searchFixtures = []struct {
+	id      uint64
+	userID  uint64
+	subject string
+	content string
+	rating  int32
+	status  string
+	replies int
+	created string
+	deleted bool
+}{
+	{1, 101, "电池很耐用", "续航一整天没问题", 5, biz.StatusApproved, 1, "2024-03-05 10:00:00", false},
+	{2, 102, "屏幕清晰", "电池一般，屏幕很亮", 4, biz.StatusApproved, 0, "2024-03-01 10:00:00", false},
+	{3, 101, "物流很快", "包装完好", 3, biz.StatusApproved, 2, "2024-03-09 10:00:00", false},
+	{4, 103, "电池鼓包", "用了一个月电池就坏了", 1, biz.StatusApproved, 0, "2024-03-03 10:00:00", false},
+	{5, 102, "屏幕漏光", "边缘漏光严重", 2, biz.StatusPending, 0, "2024-03-07 10:00:00", false},
+	{6, 104, "音质不错", "低音浑厚，电池也耐用", 4, biz.StatusApproved, 1, "2024-03-02 10:00:00", false},
+	{7, 101, "做工精致", "屏幕和电池都不错", 5, biz.StatusRejected, 0, "2024-03-08 10:00:00", false},
+	{8, 103, "性价比高", "续航和屏幕都满意", 3, biz.StatusApproved, 0, "2024-03-04 10:00:00", false},
+	{9, 104, "电池已删除", "这条评价已被删除", 5, biz.StatusApproved, 0, "2024-03-06 10:00:00", true},
+}
+
+func TestSearchBackendsAgree(t *testing.T) {
+	dsn, esAddrs := os.Getenv("REVIEW_TEST_MYSQL_DSN"), os.Getenv("REVIEW_TEST_ES_ADDRESSES")
+	if dsn == "" || esAddrs == "" {
+		t.Skip("REVIEW_TEST_MYSQL_DSN and REVIEW_TEST_ES_ADDRESSES are not set")
+	}
+	ctx := context.Background()
+	d := &Data{DB: openTestDB(ctx, t, dsn)}
+	d.ES, d.ESIndex = openTestES(ctx, t, d.DB, strings.Split(esAddrs, ","))
+
+	backends := map[string]biz.ReviewSearcher{
+		"mysql":         &mysqlSearcher{data: d},
+		"elasticsearch": &esSearcher{data: d},
+	}
+	approved := []string{biz.StatusApproved}
+	tests := []struct {
+		name  string
+		query biz.ReviewQuery
+		want  []uint64
+		total int64
+		// relevance order with Q depends on each engine's scoring
+		unordered bool
+	}{
+		{name: "relevance without q is newest id first", query: biz.ReviewQuery{Page: 1, PageSize: 3, Statuses: approved}, want: []uint64{8, 6, 4}, total: 6},
+		{name: "second page", query: biz.ReviewQuery{Page: 2, PageSize: 3, Statuses: approved}, want: []uint64{3, 2, 1}, total: 6},
+		{name: "page past the end", query: biz.ReviewQuery{Page: 3, PageSize: 3, Statuses: approved}, want: []uint64{}, total: 6},
+		{name: "q by relevance", query: biz.ReviewQuery{Page: 1, PageSize: 10, Q: "电池", Statuses: approved}, want: []uint64{1, 2, 4, 6}, total: 4, unordered: true},
+		{name: "q requires every term", query: biz.ReviewQuery{Page: 1, PageSize: 10, Q: "电池 屏幕"}, want: []uint64{2, 7}, total: 2, unordered: true},
+		{name: "q sorted by ts desc", query: biz.ReviewQuery{Page: 1, PageSize: 10, Q: "电池", Statuses: approved, Sort: "ts", Order: "desc"}, want: []uint64{1, 4, 6, 2}, total: 4},
+		{name: "q sorted by ts asc", query: biz.ReviewQuery{Page: 1, PageSize: 10, Q: "续航", Statuses: approved, Sort: "ts", Order: "asc"}, want: []uint64{8, 1}, total: 2},
+		{name: "user sorted by ts asc", query: biz.ReviewQuery{Page: 1, PageSize: 10, UserID: 101, Sort: "ts", Order: "asc"}, want: []uint64{1, 7, 3}, total: 3},
+		{name: "rating range sorted by rating desc", query: biz.ReviewQuery{Page: 1, PageSize: 10, RatingMin: 2, RatingMax: 4, Sort: "rating", Order: "desc"}, want: []uint64{6, 2, 8, 3, 5}, total: 5},
+		{name: "rating min sorted by rating asc", query: biz.ReviewQuery{Page: 1, PageSize: 10, RatingMin: 4, Sort: "rating", Order: "asc"}, want: []uint64{2, 6, 1, 7}, total: 4},
+		{name: "rating max paged by ts desc", query: biz.ReviewQuery{Page: 2, PageSize: 2, RatingMax: 3, Sort: "ts", Order: "desc"}, want: []uint64{8, 4}, total: 4},
+		{name: "has reply", query: biz.ReviewQuery{Page: 1, PageSize: 10, HasReply: ptr(true), Statuses: approved}, want: []uint64{6, 3, 1}, total: 3},
+		{name: "has no reply sorted by ts asc", query: biz.ReviewQuery{Page: 1, PageSize: 10, HasReply: ptr(false), Sort: "ts", Order: "asc"}, want: []uint64{2, 4, 8, 5, 7}, total: 5},
+		{name: "owner sees own pending", query: biz.ReviewQuery{Page: 1, PageSize: 10, Statuses: approved, OwnerID: 102, OwnerStatuses: []string{biz.StatusPending}, Sort: "ts", Order: "desc"}, want: []uint64{3, 5, 1, 8, 4, 6, 2}, total: 7},
+	}
+	for _, tt := range tests {
+		for name, s := range backends {
+			t.Run(tt.name+"/"+name, func(t *testing.T) {
+				q := tt.query
+				if q.Sort == "" {
+					q.Sort = "relevance"
+				}
+				if q.Order == "" {
+					q.Order = "desc"
+				}
+				list, total, err := s.Search(ctx, &q)
+				if err != nil {
+					t.Fatalf("search: %v", err)
+				}
+				got := make([]uint64, 0, len(list))
+				for _, r := range list {
+					got = append(got, r.ID)
+					if r.Version == 0 {
+						t.Errorf("review %d has no version", r.ID)
+					}
+				}
+				want := slices.Clone(tt.want)
+				if tt.unordered {
+					slices.Sort(got)
+					slices.Sort(want)
+				}
+				if total != tt.total || !slices.Equal(got, want) {
+					t.Errorf("got %v (total %d), want %v (total %d)", got, total, want, tt.total)
+				}
+			})
+		}
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
+
+// openTestDB migrates the database at dsn and replaces its reviews with
+// searchFixtures.
+func openTestDB(ctx context.Context, t *testing.T, dsn string) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	m, err := NewMigrator(db, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	for _, stmt := range []string{`DELETE FROM review_replies`, `DELETE FROM reviews`} {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, f := range searchFixtures {
+		var deletedAt any
+		if f.deleted {
+			deletedAt = f.created
+		}
+		_, err := db.ExecContext(ctx, `
+			INSERT INTO reviews (id, user_id, subject, content, rating, status, reply_count, created_at, updated_at, deleted_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		`, f.id, f.userID, f.subject, f.content, f.rating, f.status, f.replies, f.created, f.created, deletedAt)
+		if err != nil {
+			t.Fatalf("insert review %d: %v", f.id, err)
+		}
+	}
+	return db
+}
+
+// openTestES creates a uniquely named index and indexes every review that
+// is not soft-deleted, as review-task does.
+func openTestES(ctx context.Context, t *testing.T, db *sql.DB, addrs []string) (*esv8.Client, string) {
+	t.Helper()
+	es, err := esv8.NewClient(esv8.Config{Addresses: addrs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	index := fmt.Sprintf("reviews-test-%d", time.Now().UnixNano())
+	m := NewESIndexManager(es, &conf.Data_Elasticsearch{Index: index}, log.DefaultLogger)
+	t.Cleanup(func() {
+		_ = esResult(es.Indices.Delete([]string{index + "-*"}))
+		_ = esResult(es.Indices.DeleteIndexTemplate(m.TemplateName()))
+	})
+	if err := m.Apply(ctx); err != nil {
+		t.Fatalf("apply index template: %v", err)
+	}
+	targets, err := m.WriteIndices(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ops []ESBulkOp
+	for _, f := range searchFixtures {
+		rev, err := loadReview(ctx, db, f.id)
+		if err == biz.ErrReviewNotFound {
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, target := range targets {
+			ops = append(ops, ESBulkOp{Action: "index", Index: target, ID: fmt.Sprint(rev.ID), Doc: ReviewDocument(rev, rev.UpdatedAt), Version: rev.Version})
+		}
+	}
+	results, err := ESBulk(ctx, es, ops)
+	if err != nil {
+		t.Fatalf("bulk index: %v", err)
+	}
+	for i, r := range results {
+		if r.Failed() {
+			t.Fatalf("index %s: %d %s", ops[i].ID, r.Status, r.Error)
+		}
+	}
+	if err := esResult(es.Indices.Refresh(es.Indices.Refresh.WithIndex(index))); err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	return es, index
+}
@@ -0,0 +1,123 @@
+package data
+
+import (
+	"context"
+	"strings"
+
+	"review-service/internal/biz"
+)
+
+// mysqlSearcher runs a ReviewQuery against MySQL with the same semantics as
+// esSearcher: Q must match every term in subject or content (FULLTEXT, ngram
+// parser), user and rating filters are exact, "ts" sorts by creation time on
+// both, and ties are broken by id. Relevance order without Q falls back to
+// newest first. Relevance scores themselves differ between the engines.
+type mysqlSearcher struct {
+	data *Data
+}
+
+func (s *mysqlSearcher) Search(ctx context.Context, in *biz.ReviewQuery) ([]*biz.Review, int64, error) {
+	where := []string{"deleted_at IS NULL"}
+	var args []any
+	match := ""
+	if q := booleanModeQuery(in.Q); q != "" {
+		match = "MATCH(subject, content) AGAINST (? IN BOOLEAN MODE)"
+		where = append(where, match)
+		args = append(args, q)
+	}
+	if in.UserID != 0 {
+		where = append(where, "user_id = ?")
+		args = append(args, in.UserID)
+	}
+	if in.RatingMin != 0 {
+		where = append(where, "rating >= ?")
+		args = append(args, in.RatingMin)
+	}
+	if in.RatingMax != 0 {
+		where = append(where, "rating <= ?")
+		args = append(args, in.RatingMax)
+	}
+	if in.HasReply != nil {
+		if *in.HasReply {
+			where = append(where, "reply_count > 0")
+		} else {
+			where = append(where, "reply_count = 0")
+		}
+	}
+	if len(in.Statuses) > 0 || len(in.OwnerStatuses) > 0 {
+		visible := []string{"FALSE"}
+		if len(in.Statuses) > 0 {
+			visible = append(visible, "status IN ("+placeholders(len(in.Statuses))+")")
+			for _, st := range in.Statuses {
+				args = append(args, st)
+			}
+		}
+		if in.OwnerID != 0 && len(in.OwnerStatuses) > 0 {
+			visible = append(visible, "(user_id = ? AND status IN ("+placeholders(len(in.OwnerStatuses))+"))")
+			args = append(args, in.OwnerID)
+			for _, st := range in.OwnerStatuses {
+				args = append(args, st)
+			}
+		}
+		where = append(where, "("+strings.Join(visible, " OR ")+")")
+	}
+	cond := " WHERE " + strings.Join(where, " AND ")
+
+	var total int64
+	if err := s.data.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM reviews`+cond, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	dir := "DESC"
+	if strings.EqualFold(in.Order, "asc") {
+		dir = "ASC"
+	}
+	var orderBy string
+	var orderArgs []any
+	switch {
+	case in.Sort == "rating":
+		orderBy = "rating " + dir + ", id " + dir
+	case in.Sort == "ts":
+		orderBy = "created_at " + dir + ", id " + dir
+	case match != "":
+		// relevance, like ES _score
+		orderBy = match + " DESC, id DESC"
+		orderArgs = append(orderArgs, args[0])
+	default:
+		orderBy = "id DESC"
+	}
+
+	query := `SELECT ` + reviewColumns + ` FROM reviews` + cond + ` ORDER BY ` + orderBy + ` LIMIT ? OFFSET ?`
+	qargs := append(append(append([]any{}, args...), orderArgs...), in.PageSize, (in.Page-1)*in.PageSize)
+	rows, err := s.data.DB.QueryContext(ctx, query, qargs...)
+	if err != nil {
+		return nil, 0, err
+	}
+	list, err := scanReviews(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+// booleanModeQuery turns free text into a BOOLEAN MODE query requiring every
+// term, like the ES multi_match "and" operator. Operator characters are
+// dropped and each term is quoted so the ngram parser matches it as a phrase.
+func booleanModeQuery(q string) string {
+	clean := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`+-<>()~*"@`, r) {
+			return ' '
+		}
+		return r
+	}, q)
+	terms := strings.Fields(clean)
+	for i, t := range terms {
+		terms[i] = `+"` + t + `"`
+	}
+	return strings.Join(terms, " ")
+}
+
+// placeholders returns n comma-separated "?".
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
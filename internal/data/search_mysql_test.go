@@ -0,0 +1,36 @@
+package data
+
+import "testing"
+
+func TestBooleanModeQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		q    string
+		want string
+	}{
+		{name: "empty", q: "", want: ""},
+		{name: "blank", q: "  \t ", want: ""},
+		{name: "single term", q: "电池", want: `+"电池"`},
+		{name: "every term required", q: "电池 续航", want: `+"电池" +"续航"`},
+		{name: "extra whitespace", q: "  battery \t life  ", want: `+"battery" +"life"`},
+		{name: "operators dropped", q: `+电池 -屏幕 ~续航 <a> (b) c*`, want: `+"电池" +"屏幕" +"续航" +"a" +"b" +"c"`},
+		{name: "quotes cannot escape the phrase", q: `"电池" 续"航`, want: `+"电池" +"续" +"航"`},
+		{name: "at sign dropped", q: "a@b", want: `+"a" +"b"`},
+		{name: "only operators", q: `+-<>()~*"@`, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := booleanModeQuery(tt.q); got != tt.want {
+				t.Errorf("booleanModeQuery(%q) = %q, want %q", tt.q, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlaceholders(t *testing.T) {
+	for n, want := range map[int]string{0: "", 1: "?", 3: "?, ?, ?"} {
+		if got := placeholders(n); got != want {
+			t.Errorf("placeholders(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+
+	"github.com/XSAM/otelsql"
+	redis "github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "review-service/internal/data"
+
+// sqlSpanOptions traces statements of traced requests only, so the outbox
+// relay and purge job polling MySQL do not start a trace each.
+var sqlSpanOptions = otelsql.SpanOptions{
+	OmitConnResetSession: true,
+	OmitConnPrepare:      true,
+	OmitRows:             true,
+	OmitConnectorConnect: true,
+	SpanFilter: func(ctx context.Context, _ otelsql.Method, _ string, _ []driver.NamedValue) bool {
+		return trace.SpanContextFromContext(ctx).IsValid()
+	},
+}
+
+// redisTracing is a go-redis hook adding a client span per command or
+// pipeline of a traced request.
+type redisTracing struct {
+	tracer trace.Tracer
+}
+
+func newRedisTracing() redisTracing {
+	return redisTracing{tracer: otel.Tracer(tracerName)}
+}
+
+func (redisTracing) DialHook(next redis.DialHook) redis.DialHook { return next }
+
+func (t redisTracing) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if !trace.SpanContextFromContext(ctx).IsValid() {
+			return next(ctx, cmd)
+		}
+		ctx, span := t.tracer.Start(ctx, "redis "+cmd.Name(),
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemRedis, attribute.String("db.operation.name", cmd.Name())),
+		)
+		defer span.End()
+		err := next(ctx, cmd)
+		endRedisSpan(span, err)
+		return err
+	}
+}
+
+func (t redisTracing) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		if !trace.SpanContextFromContext(ctx).IsValid() {
+			return next(ctx, cmds)
+		}
+		names := make([]string, len(cmds))
+		for i, c := range cmds {
+			names[i] = c.Name()
+		}
+		ctx, span := t.tracer.Start(ctx, "redis pipeline",
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemRedis, attribute.String("db.operation.name", strings.Join(names, " "))),
+		)
+		defer span.End()
+		err := next(ctx, cmds)
+		endRedisSpan(span, err)
+		return err
+	}
+}
+
+// endRedisSpan records err unless it is a cache miss.
+func endRedisSpan(span trace.Span, err error) {
+	if err != nil && err != redis.Nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
@@ -0,0 +1,219 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	hv1 "review-service/api/helloworld/v1"
+	rv1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// permission says who may call an RPC.
+type permission struct {
+	// anonymous callers are let through; a token, if sent, is still verified
+	public bool
+	// roles allowed to call it; empty means any authenticated caller
+	roles []string
+}
+
+// permissions lists the access rule of every RPC. RPCs not listed require an
+// authenticated caller of any role.
+var permissions = map[string]permission{
+	hv1.OperationGreeterSayHello: {public: true},
+
+	// probes of orchestrators and load balancers
+	grpc_health_v1.Health_Check_FullMethodName: {public: true},
+	grpc_health_v1.Health_Watch_FullMethodName: {public: true},
+
+	rv1.OperationReviewGetReview:   {public: true},
+	rv1.OperationReviewListReview:  {public: true},
+	rv1.OperationReviewListReplies: {public: true},
+
+	rv1.OperationReviewCreateReview: {roles: []string{biz.RoleCustomer}},
+	rv1.OperationReviewUpdateReview: {roles: []string{biz.RoleCustomer, biz.RoleOperator}},
+	rv1.OperationReviewDeleteReview: {roles: []string{biz.RoleCustomer, biz.RoleOperator}},
+	rv1.OperationReviewAppealReview: {roles: []string{biz.RoleCustomer}},
+
+	rv1.OperationReviewCreateReply: {roles: []string{biz.RoleMerchant}},
+
+	rv1.OperationReviewAuditReview:       {roles: []string{biz.RoleOperator}},
+	rv1.OperationReviewListAuditHistory:  {roles: []string{biz.RoleOperator}},
+	rv1.OperationReviewListPendingReview: {roles: []string{biz.RoleOperator}},
+	rv1.OperationReviewRestoreReview:     {roles: []string{biz.RoleOperator}},
+}
+
+func (p permission) allows(role string) bool {
+	if len(p.roles) == 0 {
+		return true
+	}
+	for _, r := range p.roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrInvalidClaims is returned for a verified token without an expiry, or
+// without a usable subject or role.
+var ErrInvalidClaims = rv1.ErrorInvalidClaims("token must carry exp, a numeric sub and a known role")
+
+// claims are the JWT claims issued to callers: sub is the user, merchant or
+// operator id, role one of biz.RoleCustomer, RoleMerchant, RoleOperator.
+type claims struct {
+	jwtv5.RegisteredClaims
+	Role string `json:"role"`
+}
+
+// Auth verifies JWTs against the configured keys and enforces permissions.
+// The caller it derives is passed on as a biz.Caller.
+type Auth struct {
+	method   jwtv5.SigningMethod
+	keys     map[string]any
+	first    any
+	issuer   string
+	audience string
+}
+
+// NewAuth parses the keys in c.Auth. It returns a nil *Auth, which lets every
+// request through, when no key is configured.
+func NewAuth(c *conf.Server, logger log.Logger) (*Auth, error) {
+	ac := c.GetAuth()
+	if len(ac.GetKeys()) == 0 {
+		log.NewHelper(logger).Warn("server.auth has no keys: authentication is DISABLED")
+		return nil, nil
+	}
+	alg := ac.GetAlgorithm()
+	if alg == "" {
+		alg = jwtv5.SigningMethodHS256.Alg()
+	}
+	a := &Auth{
+		method:   jwtv5.GetSigningMethod(alg),
+		keys:     make(map[string]any, len(ac.Keys)),
+		issuer:   ac.Issuer,
+		audience: ac.Audience,
+	}
+	if a.method == nil {
+		return nil, fmt.Errorf("server.auth: unknown algorithm %q", alg)
+	}
+	for i, k := range ac.Keys {
+		key, err := parseVerifyKey(alg, k.Key)
+		if err != nil {
+			return nil, fmt.Errorf("server.auth: key %q: %w", k.Id, err)
+		}
+		if i == 0 {
+			a.first = key
+		}
+		a.keys[k.Id] = key
+	}
+	return a, nil
+}
+
+func parseVerifyKey(alg, material string) (any, error) {
+	switch {
+	case strings.HasPrefix(alg, "HS"):
+		return []byte(material), nil
+	case strings.HasPrefix(alg, "RS"), strings.HasPrefix(alg, "PS"):
+		return jwtv5.ParseRSAPublicKeyFromPEM([]byte(material))
+	case strings.HasPrefix(alg, "ES"):
+		return jwtv5.ParseECPublicKeyFromPEM([]byte(material))
+	case alg == "EdDSA":
+		return jwtv5.ParseEdPublicKeyFromPEM([]byte(material))
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q", alg)
+	}
+}
+
+func (a *Auth) keyFunc(t *jwtv5.Token) (any, error) {
+	kid, _ := t.Header["kid"].(string)
+	if kid == "" {
+		return a.first, nil
+	}
+	if key, ok := a.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown kid %q", kid)
+}
+
+// Middleware authenticates the caller and checks the RPC's permission.
+func (a *Auth) Middleware() middleware.Middleware {
+	if a == nil {
+		return func(h middleware.Handler) middleware.Handler { return h }
+	}
+	verify := jwt.Server(a.keyFunc,
+		jwt.WithSigningMethod(a.method),
+		jwt.WithClaims(func() jwtv5.Claims { return &claims{} }),
+	)
+	return func(handler middleware.Handler) middleware.Handler {
+		authorized := verify(func(ctx context.Context, req any) (any, error) {
+			caller, err := a.caller(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if tr, ok := transport.FromServerContext(ctx); ok && !permissions[tr.Operation()].allows(caller.Role) {
+				return nil, biz.ErrPermissionDenied
+			}
+			return handler(biz.NewCallerContext(ctx, caller), req)
+		})
+		return func(ctx context.Context, req any) (any, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, jwt.ErrWrongContext
+			}
+			if permissions[tr.Operation()].public && tr.RequestHeader().Get("Authorization") == "" {
+				return handler(ctx, req)
+			}
+			return authorized(ctx, req)
+		}
+	}
+}
+
+// caller validates the verified claims and turns them into a biz.Caller.
+// The parser checks exp only when it is present, so tokens without one, which
+// would never expire, are rejected here.
+func (a *Auth) caller(ctx context.Context) (*biz.Caller, error) {
+	tc, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, jwt.ErrMissingJwtToken
+	}
+	c := tc.(*claims)
+	if c.ExpiresAt == nil {
+		return nil, ErrInvalidClaims
+	}
+	if a.issuer != "" && c.Issuer != a.issuer {
+		return nil, jwt.ErrTokenInvalid
+	}
+	if a.audience != "" && !containsString(c.Audience, a.audience) {
+		return nil, jwt.ErrTokenInvalid
+	}
+	id, err := strconv.ParseUint(c.Subject, 10, 64)
+	if err != nil || id == 0 {
+		return nil, ErrInvalidClaims
+	}
+	switch c.Role {
+	case biz.RoleCustomer, biz.RoleMerchant, biz.RoleOperator:
+	default:
+		return nil, ErrInvalidClaims
+	}
+	return &biz.Caller{UserID: id, Role: c.Role}, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,250 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	rv1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+)
+
+// testTransport is the server transport of a request to operation.
+type testTransport struct {
+	kind      transport.Kind
+	operation string
+	header    headerCarrier
+	reply     headerCarrier
+}
+
+func newTestTransport(operation string) *testTransport {
+	return &testTransport{kind: transport.KindHTTP, operation: operation, header: headerCarrier(http.Header{}), reply: headerCarrier(http.Header{})}
+}
+
+func (t *testTransport) Kind() transport.Kind            { return t.kind }
+func (t *testTransport) Endpoint() string                { return "" }
+func (t *testTransport) Operation() string               { return t.operation }
+func (t *testTransport) RequestHeader() transport.Header { return t.header }
+func (t *testTransport) ReplyHeader() transport.Header   { return t.reply }
+func (t *testTransport) request(ctx context.Context) context.Context {
+	return transport.NewServerContext(ctx, t)
+}
+
+type headerCarrier http.Header
+
+func (h headerCarrier) Get(key string) string      { return http.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { http.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { http.Header(h).Add(key, value) }
+func (h headerCarrier) Values(key string) []string { return http.Header(h).Values(key) }
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+const (
+	testKeyID    = "k1"
+	testSecret   = "first-secret"
+	otherKeyID   = "k2"
+	otherSecret  = "second-secret"
+	testIssuer   = "review-auth"
+	testAudience = "review-service"
+)
+
+func newTestAuth(t *testing.T) *Auth {
+	t.Helper()
+	a, err := NewAuth(&conf.Server{Auth: &conf.Server_Auth{
+		Keys: []*conf.Server_Auth_Key{
+			{Id: testKeyID, Key: testSecret},
+			{Id: otherKeyID, Key: otherSecret},
+		},
+		Issuer:   testIssuer,
+		Audience: testAudience,
+	}}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+// token describes a signed token; validToken returns one the test Auth accepts.
+type token struct {
+	kid, secret string
+	method      jwtv5.SigningMethod
+	claims      claims
+}
+
+func validToken(sub, role string) token {
+	return token{
+		kid:    testKeyID,
+		secret: testSecret,
+		method: jwtv5.SigningMethodHS256,
+		claims: claims{
+			RegisteredClaims: jwtv5.RegisteredClaims{
+				Subject:   sub,
+				Issuer:    testIssuer,
+				Audience:  jwtv5.ClaimStrings{testAudience},
+				ExpiresAt: jwtv5.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			Role: role,
+		},
+	}
+}
+
+func (tk token) sign(t *testing.T) string {
+	t.Helper()
+	jt := jwtv5.NewWithClaims(tk.method, tk.claims)
+	if tk.kid != "" {
+		jt.Header["kid"] = tk.kid
+	}
+	s, err := jt.SignedString([]byte(tk.secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	isUnauthorized := func(err error) bool { return errors.Code(err) == http.StatusUnauthorized }
+	with := func(tk token, f func(*token)) *token { f(&tk); return &tk }
+	customer := validToken("42", biz.RoleCustomer)
+
+	tests := []struct {
+		name      string
+		operation string
+		token     *token
+		header    string // raw Authorization header, overrides token
+		check     func(error) bool
+		caller    *biz.Caller
+	}{
+		{name: "public without token", operation: rv1.OperationReviewGetReview},
+		{name: "public with token", operation: rv1.OperationReviewGetReview, token: &customer, caller: &biz.Caller{UserID: 42, Role: biz.RoleCustomer}},
+		{name: "public with bad token", operation: rv1.OperationReviewGetReview, header: "Bearer not-a-jwt", check: isUnauthorized},
+		{name: "public with token signed by another key", operation: rv1.OperationReviewListReview,
+			token: with(customer, func(tk *token) { tk.secret = "wrong" }), check: isUnauthorized},
+		{name: "private without token", operation: rv1.OperationReviewCreateReview, check: isUnauthorized},
+		{name: "allowed role", operation: rv1.OperationReviewCreateReview, token: &customer, caller: &biz.Caller{UserID: 42, Role: biz.RoleCustomer}},
+		{name: "second key by kid", operation: rv1.OperationReviewCreateReview,
+			token: with(customer, func(tk *token) { tk.kid, tk.secret = otherKeyID, otherSecret }), caller: &biz.Caller{UserID: 42, Role: biz.RoleCustomer}},
+		{name: "first key without kid", operation: rv1.OperationReviewCreateReview,
+			token: with(customer, func(tk *token) { tk.kid = "" }), caller: &biz.Caller{UserID: 42, Role: biz.RoleCustomer}},
+		{name: "role not allowed", operation: rv1.OperationReviewAuditReview, token: &customer, check: rv1.IsPermissionDenied},
+		{name: "merchant may not create", operation: rv1.OperationReviewCreateReview,
+			token: with(customer, func(tk *token) { tk.claims.Role = biz.RoleMerchant }), check: rv1.IsPermissionDenied},
+		{name: "operator audits", operation: rv1.OperationReviewAuditReview,
+			token: with(customer, func(tk *token) { tk.claims.Role = biz.RoleOperator }), caller: &biz.Caller{UserID: 42, Role: biz.RoleOperator}},
+		{name: "non-numeric sub", operation: rv1.OperationReviewCreateReview,
+			token: with(customer, func(tk *token) { tk.claims.Subject = "alice" }), check: rv1.IsInvalidClaims},
+		{name: "zero sub", operation: rv1.OperationReviewCreateReview,
+			token: with(customer, func(tk *token) { tk.claims.Subject = "0" }), check: rv1.IsInvalidClaims},
+		{name: "unknown role", operation: rv1.OperationReviewCreateReview,
+			token: with(customer, func(tk *token) { tk.claims.Role = "admin" }), check: rv1.IsInvalidClaims},
+		{name: "unknown role on a public rpc", operation: rv1.OperationReviewGetReview,
+			token: with(customer, func(tk *token) { tk.claims.Role = "admin" }), check: rv1.IsInvalidClaims},
+		{name: "no exp", operation: rv1.OperationReviewCreateReview,
+			token: with(customer, func(tk *token) { tk.claims.ExpiresAt = nil }), check: rv1.IsInvalidClaims},
+		{name: "expired", operation: rv1.OperationReviewCreateReview,
+			token: with(customer, func(tk *token) { tk.claims.ExpiresAt = jwtv5.NewNumericDate(time.Now().Add(-time.Minute)) }), check: isUnauthorized},
+		{name: "unknown kid", operation: rv1.OperationReviewCreateReview,
+			token: with(customer, func(tk *token) { tk.kid = "k3" }), check: isUnauthorized},
+		{name: "other signing method", operation: rv1.OperationReviewCreateReview,
+			token: with(customer, func(tk *token) { tk.method = jwtv5.SigningMethodHS512 }), check: isUnauthorized},
+		{name: "issuer mismatch", operation: rv1.OperationReviewCreateReview,
+			token: with(customer, func(tk *token) { tk.claims.Issuer = "someone-else" }), check: isUnauthorized},
+		{name: "audience mismatch", operation: rv1.OperationReviewCreateReview,
+			token: with(customer, func(tk *token) { tk.claims.Audience = jwtv5.ClaimStrings{"other-service"} }), check: isUnauthorized},
+	}
+	mw := newTestAuth(t).Middleware()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				called bool
+				caller *biz.Caller
+			)
+			h := mw(func(ctx context.Context, _ any) (any, error) {
+				called, caller = true, biz.CallerFromContext(ctx)
+				return "ok", nil
+			})
+			tr := newTestTransport(tt.operation)
+			switch {
+			case tt.header != "":
+				tr.header.Set("Authorization", tt.header)
+			case tt.token != nil:
+				tr.header.Set("Authorization", "Bearer "+tt.token.sign(t))
+			}
+			_, err := h(tr.request(context.Background()), nil)
+			if tt.check != nil {
+				if !tt.check(err) {
+					t.Fatalf("error = %v", err)
+				}
+				if called {
+					t.Fatal("handler called")
+				}
+				return
+			}
+			if err != nil || !called {
+				t.Fatalf("error = %v, called = %v", err, called)
+			}
+			if (caller == nil) != (tt.caller == nil) || caller != nil && *caller != *tt.caller {
+				t.Errorf("caller = %+v, want %+v", caller, tt.caller)
+			}
+		})
+	}
+}
+
+func TestAuthDisabledWithoutKeys(t *testing.T) {
+	a, err := NewAuth(&conf.Server{}, log.DefaultLogger)
+	if err != nil || a != nil {
+		t.Fatalf("NewAuth() = %v, %v; want nil, nil", a, err)
+	}
+	called := false
+	h := a.Middleware()(func(context.Context, any) (any, error) { called = true; return nil, nil })
+	tr := newTestTransport(rv1.OperationReviewAuditReview)
+	if _, err := h(tr.request(context.Background()), nil); err != nil || !called {
+		t.Fatalf("error = %v, called = %v", err, called)
+	}
+}
+
+func TestPermissions(t *testing.T) {
+	// a role list on a public RPC would only apply to callers with a token
+	for op, p := range permissions {
+		if p.public && len(p.roles) > 0 {
+			t.Errorf("%s: public RPCs take no roles", op)
+		}
+	}
+	// each restricted RPC admits exactly its roles
+	tests := []struct {
+		operation string
+		allowed   []string
+	}{
+		{rv1.OperationReviewCreateReview, []string{biz.RoleCustomer}},
+		{rv1.OperationReviewUpdateReview, []string{biz.RoleCustomer, biz.RoleOperator}},
+		{rv1.OperationReviewDeleteReview, []string{biz.RoleCustomer, biz.RoleOperator}},
+		{rv1.OperationReviewAppealReview, []string{biz.RoleCustomer}},
+		{rv1.OperationReviewCreateReply, []string{biz.RoleMerchant}},
+		{rv1.OperationReviewAuditReview, []string{biz.RoleOperator}},
+		{rv1.OperationReviewRestoreReview, []string{biz.RoleOperator}},
+		{rv1.OperationReviewListAuditHistory, []string{biz.RoleOperator}},
+		{rv1.OperationReviewListPendingReview, []string{biz.RoleOperator}},
+	}
+	for _, tt := range tests {
+		for _, role := range []string{biz.RoleCustomer, biz.RoleMerchant, biz.RoleOperator} {
+			want := false
+			for _, r := range tt.allowed {
+				want = want || r == role
+			}
+			if got := permissions[tt.operation].allows(role); got != want {
+				t.Errorf("%s allows %s = %v, want %v", tt.operation, role, got, want)
+			}
+		}
+	}
+}
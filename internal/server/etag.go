@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	rv1 "review-service/api/review/v1"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// ErrInvalidIfMatch is returned for an If-Match header that is not a review version.
+var ErrInvalidIfMatch = rv1.ErrorInvalidIfMatch(`If-Match must be a review version ETag, e.g. "3"`)
+
+// ETag maps review versions onto HTTP caching headers: GetReview replies
+// carry the version as an ETag, and an If-Match header on UpdateReview or
+// AuditReview is taken as the expected version unless the body sets one.
+func ETag() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok || tr.Kind() != transport.KindHTTP {
+				return handler(ctx, req)
+			}
+			if im := tr.RequestHeader().Get("If-Match"); im != "" && im != "*" {
+				v, err := parseETag(im)
+				if err != nil {
+					return nil, err
+				}
+				switch r := req.(type) {
+				case *rv1.UpdateReviewRequest:
+					if r.ExpectedVersion == 0 {
+						r.ExpectedVersion = v
+					}
+				case *rv1.AuditReviewRequest:
+					if r.ExpectedVersion == 0 {
+						r.ExpectedVersion = v
+					}
+				}
+			}
+			reply, err := handler(ctx, req)
+			if r, ok := reply.(*rv1.GetReviewReply); ok && r.GetReview() != nil {
+				tr.ReplyHeader().Set("ETag", formatETag(r.Review.Version))
+			}
+			return reply, err
+		}
+	}
+}
+
+func formatETag(version uint64) string {
+	return `"` + strconv.FormatUint(version, 10) + `"`
+}
+
+// parseETag accepts a single strong or weak ETag written by formatETag.
+func parseETag(s string) (uint64, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "W/")
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return 0, ErrInvalidIfMatch
+	}
+	v, err := strconv.ParseUint(s[1:len(s)-1], 10, 64)
+	if err != nil || v == 0 {
+		return 0, ErrInvalidIfMatch
+	}
+	return v, nil
+}
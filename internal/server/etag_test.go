@@ -0,0 +1,142 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	rv1 "review-service/api/review/v1"
+
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+func TestParseETag(t *testing.T) {
+	tests := []struct {
+		in   string
+		want uint64
+		ok   bool
+	}{
+		{in: `"3"`, want: 3, ok: true},
+		{in: ` "42" `, want: 42, ok: true},
+		{in: `W/"3"`, want: 3, ok: true},
+		{in: `"18446744073709551615"`, want: 18446744073709551615, ok: true},
+		{in: `3`},
+		{in: `"3`},
+		{in: `3"`},
+		{in: `""`},
+		{in: `"`},
+		{in: `"0"`},
+		{in: `"-1"`},
+		{in: `"abc"`},
+		{in: `"3", "4"`},
+		{in: `w/"3"`},
+		{in: `W/3`},
+		{in: `*`},
+		{in: `"18446744073709551616"`},
+	}
+	for _, tt := range tests {
+		v, err := parseETag(tt.in)
+		if tt.ok && (err != nil || v != tt.want) {
+			t.Errorf("parseETag(%q) = %d, %v; want %d", tt.in, v, err, tt.want)
+		}
+		if !tt.ok && !rv1.IsInvalidIfMatch(err) {
+			t.Errorf("parseETag(%q) = %d, %v; want INVALID_IF_MATCH", tt.in, v, err)
+		}
+	}
+	if got := formatETag(7); got != `"7"` {
+		t.Errorf("formatETag(7) = %s", got)
+	}
+}
+
+func TestETagIfMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		ifMatch string
+		// expected_version set in the body
+		body  uint64
+		want  uint64
+		check func(error) bool
+	}{
+		{name: "no header", body: 0, want: 0},
+		{name: "quoted", ifMatch: `"3"`, want: 3},
+		{name: "weak", ifMatch: `W/"3"`, want: 3},
+		{name: "any version", ifMatch: `*`, want: 0},
+		{name: "malformed", ifMatch: `three`, check: rv1.IsInvalidIfMatch},
+		{name: "zero", ifMatch: `"0"`, check: rv1.IsInvalidIfMatch},
+		{name: "body alone", body: 5, want: 5},
+		{name: "body wins over the header", ifMatch: `"3"`, body: 5, want: 5},
+		{name: "malformed header rejected even with a body version", ifMatch: `three`, body: 5, check: rv1.IsInvalidIfMatch},
+	}
+	for _, tt := range tests {
+		reqs := map[string]func() (any, func() uint64){
+			rv1.OperationReviewUpdateReview: func() (any, func() uint64) {
+				r := &rv1.UpdateReviewRequest{ExpectedVersion: tt.body}
+				return r, func() uint64 { return r.ExpectedVersion }
+			},
+			rv1.OperationReviewAuditReview: func() (any, func() uint64) {
+				r := &rv1.AuditReviewRequest{ExpectedVersion: tt.body}
+				return r, func() uint64 { return r.ExpectedVersion }
+			},
+		}
+		for op, newReq := range reqs {
+			t.Run(tt.name+"/"+op, func(t *testing.T) {
+				tr := newTestTransport(op)
+				if tt.ifMatch != "" {
+					tr.header.Set("If-Match", tt.ifMatch)
+				}
+				req, expected := newReq()
+				called := false
+				h := ETag()(func(context.Context, any) (any, error) { called = true; return nil, nil })
+				_, err := h(tr.request(context.Background()), req)
+				if tt.check != nil {
+					if !tt.check(err) || called {
+						t.Fatalf("error = %v, called = %v", err, called)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := expected(); got != tt.want {
+					t.Errorf("expected_version = %d, want %d", got, tt.want)
+				}
+			})
+		}
+	}
+
+	t.Run("grpc ignores the header", func(t *testing.T) {
+		tr := newTestTransport(rv1.OperationReviewUpdateReview)
+		tr.kind = transport.KindGRPC
+		tr.header.Set("If-Match", `three`)
+		req := &rv1.UpdateReviewRequest{}
+		h := ETag()(func(context.Context, any) (any, error) { return nil, nil })
+		if _, err := h(tr.request(context.Background()), req); err != nil || req.ExpectedVersion != 0 {
+			t.Fatalf("error = %v, expected_version = %d", err, req.ExpectedVersion)
+		}
+	})
+}
+
+func TestETagHeader(t *testing.T) {
+	tests := []struct {
+		name  string
+		reply any
+		err   error
+		want  string
+	}{
+		{name: "review", reply: &rv1.GetReviewReply{Review: &rv1.ReviewRecord{Id: 1, Version: 4}}, want: `"4"`},
+		{name: "no review", reply: &rv1.GetReviewReply{}},
+		{name: "error", err: rv1.ErrorReviewNotFound("review not found")},
+		{name: "other reply", reply: &rv1.ListReviewReply{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := newTestTransport(rv1.OperationReviewGetReview)
+			h := ETag()(func(context.Context, any) (any, error) { return tt.reply, tt.err })
+			if _, err := h(tr.request(context.Background()), &rv1.GetReviewRequest{Id: 1}); err != tt.err {
+				t.Fatalf("error = %v, want %v", err, tt.err)
+			}
+			if got := tr.reply.Get("ETag"); got != tt.want {
+				t.Errorf("ETag = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -8,15 +8,24 @@ import (
 
     "github.com/go-kratos/kratos/v2/log"
     "github.com/go-kratos/kratos/v2/middleware/recovery"
+    "github.com/go-kratos/kratos/v2/middleware/tracing"
+    "github.com/go-kratos/kratos/v2/middleware/validate"
     "github.com/go-kratos/kratos/v2/transport/grpc"
+    "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, review *service.ReviewService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, review *service.ReviewService, auth *Auth, m *Metrics, h *Health, logger log.Logger) *grpc.Server {
     var opts = []grpc.ServerOption{
         grpc.Middleware(
             recovery.Recovery(),
+            tracing.Server(),
+            m.Middleware(),
+            auth.Middleware(),
+            validate.Validator(),
         ),
+        // the standard health service is served by h
+        grpc.CustomHealth(),
     }
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -30,5 +39,6 @@ func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, review *serv
     srv := grpc.NewServer(opts...)
     hv1.RegisterGreeterServer(srv, greeter)
     rv1.RegisterReviewServer(srv, review)
+    grpc_health_v1.RegisterHealthServer(srv, h.grpc)
     return srv
 }
@@ -0,0 +1,231 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	rv1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	defaultHealthInterval = 10 * time.Second
+	defaultHealthTimeout  = 2 * time.Second
+
+	// livenessService is the gRPC health service whose status ignores the
+	// dependencies, for liveness probes.
+	livenessService = "liveness"
+)
+
+var knownDependencies = map[string]bool{
+	biz.DependencyMySQL:         true,
+	biz.DependencyRedis:         true,
+	biz.DependencyElasticsearch: true,
+	biz.DependencyKafka:         true,
+}
+
+// readinessServices are the gRPC health services that follow readiness; ""
+// is the status of the whole server.
+var readinessServices = []string{"", rv1.Review_ServiceDesc.ServiceName}
+
+// healthReport is the body of /readyz.
+type healthReport struct {
+	// ok or unavailable
+	Status       string                       `json:"status"`
+	CheckedAt    *time.Time                   `json:"checked_at,omitempty"`
+	Dependencies map[string]*dependencyReport `json:"dependencies,omitempty"`
+}
+
+type dependencyReport struct {
+	// up or down
+	Status    string `json:"status"`
+	Critical  bool   `json:"critical"`
+	LatencyMS int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Health reports liveness and readiness. It checks the dependencies every
+// interval and serves the latest result through the standard gRPC health
+// service and HTTP /healthz and /readyz; the service is ready while every
+// critical dependency is up. It runs as a kratos transport.Server and stops
+// reporting ready when the application shuts down.
+type Health struct {
+	uc       *biz.HealthUsecase
+	critical map[string]bool
+	interval time.Duration
+	timeout  time.Duration
+	grpc     *health.Server
+	log      *log.Helper
+
+	mu     sync.RWMutex
+	report *healthReport
+	ready  bool
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewHealth creates the health checks from c.Health. The service is not
+// ready until the first round of checks, run by Start, passes.
+func NewHealth(c *conf.Server, uc *biz.HealthUsecase, logger log.Logger) (*Health, error) {
+	h := &Health{
+		uc:       uc,
+		critical: map[string]bool{biz.DependencyMySQL: true},
+		interval: defaultHealthInterval,
+		timeout:  defaultHealthTimeout,
+		grpc:     health.NewServer(),
+		log:      log.NewHelper(logger),
+		report:   &healthReport{Status: "unavailable"},
+	}
+	if hc := c.GetHealth(); hc != nil {
+		if len(hc.Critical) > 0 {
+			h.critical = make(map[string]bool, len(hc.Critical))
+			for _, name := range hc.Critical {
+				if !knownDependencies[name] {
+					return nil, fmt.Errorf("server.health: unknown critical dependency %q", name)
+				}
+				h.critical[name] = true
+			}
+		}
+		if hc.Interval != nil && hc.Interval.AsDuration() > 0 {
+			h.interval = hc.Interval.AsDuration()
+		}
+		if hc.Timeout != nil && hc.Timeout.AsDuration() > 0 {
+			h.timeout = hc.Timeout.AsDuration()
+		}
+	}
+	h.grpc.SetServingStatus(livenessService, grpc_health_v1.HealthCheckResponse_SERVING)
+	h.setServing(grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	return h, nil
+}
+
+// Start checks the dependencies once, then every interval until Stop.
+func (h *Health) Start(ctx context.Context) error {
+	ctx, h.cancel = context.WithCancel(ctx)
+	h.done = make(chan struct{})
+	h.check(ctx)
+	go h.run(ctx)
+	return nil
+}
+
+// Stop stops the checks and reports every service as not serving.
+func (h *Health) Stop(ctx context.Context) error {
+	if h.cancel == nil {
+		return nil
+	}
+	h.cancel()
+	select {
+	case <-h.done:
+	case <-ctx.Done():
+	}
+	h.mu.Lock()
+	h.ready = false
+	h.report = &healthReport{Status: "unavailable"}
+	h.mu.Unlock()
+	h.grpc.Shutdown()
+	return nil
+}
+
+func (h *Health) run(ctx context.Context) {
+	defer close(h.done)
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.check(ctx)
+		}
+	}
+}
+
+// check runs one round of checks and publishes the result. A critical
+// dependency that is not configured counts as down.
+func (h *Health) check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+	results := h.uc.Check(ctx)
+	if ctx.Err() == context.Canceled {
+		return
+	}
+
+	now := time.Now()
+	report := &healthReport{Status: "ok", CheckedAt: &now, Dependencies: make(map[string]*dependencyReport, len(results))}
+	for _, r := range results {
+		d := &dependencyReport{Status: "up", Critical: h.critical[r.Name], LatencyMS: r.Latency.Milliseconds()}
+		if r.Err != nil {
+			d.Status, d.Error = "down", r.Err.Error()
+		}
+		report.Dependencies[r.Name] = d
+	}
+	for name := range h.critical {
+		if _, ok := report.Dependencies[name]; !ok {
+			report.Dependencies[name] = &dependencyReport{Status: "down", Critical: true, Error: "not configured"}
+		}
+	}
+	ready := true
+	for _, d := range report.Dependencies {
+		if d.Critical && d.Status != "up" {
+			ready = false
+		}
+	}
+	if !ready {
+		report.Status = "unavailable"
+	}
+
+	h.mu.Lock()
+	changed := ready != h.ready
+	h.ready, h.report = ready, report
+	h.mu.Unlock()
+	if !changed {
+		return
+	}
+	if ready {
+		h.log.Info("service is ready")
+		h.setServing(grpc_health_v1.HealthCheckResponse_SERVING)
+	} else {
+		h.log.Warn("service is not ready: a critical dependency is down")
+		h.setServing(grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	}
+}
+
+func (h *Health) setServing(status grpc_health_v1.HealthCheckResponse_ServingStatus) {
+	for _, s := range readinessServices {
+		h.grpc.SetServingStatus(s, status)
+	}
+}
+
+// Live serves /healthz: the process is up and serving HTTP.
+func (h *Health) Live(w http.ResponseWriter, _ *http.Request) {
+	writeHealth(w, http.StatusOK, &healthReport{Status: "ok"})
+}
+
+// Ready serves /readyz with the latest check of every dependency, with
+// status 503 while the service is not ready.
+func (h *Health) Ready(w http.ResponseWriter, _ *http.Request) {
+	h.mu.RLock()
+	ready, report := h.ready, h.report
+	h.mu.RUnlock()
+	code := http.StatusOK
+	if !ready {
+		code = http.StatusServiceUnavailable
+	}
+	writeHealth(w, code, report)
+}
+
+func writeHealth(w http.ResponseWriter, code int, report *healthReport) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(report)
+}
@@ -8,14 +8,22 @@ import (
 
     "github.com/go-kratos/kratos/v2/log"
     "github.com/go-kratos/kratos/v2/middleware/recovery"
+    "github.com/go-kratos/kratos/v2/middleware/tracing"
+    "github.com/go-kratos/kratos/v2/middleware/validate"
     "github.com/go-kratos/kratos/v2/transport/http"
+    "github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, review *service.ReviewService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, review *service.ReviewService, auth *Auth, m *Metrics, h *Health, logger log.Logger) *http.Server {
     var opts = []http.ServerOption{
         http.Middleware(
             recovery.Recovery(),
+            tracing.Server(),
+            m.Middleware(),
+            auth.Middleware(),
+            validate.Validator(),
+            ETag(),
         ),
     }
     if c.Http.Network != "" {
@@ -30,5 +38,8 @@ func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, review *serv
     srv := http.NewServer(opts...)
     hv1.RegisterGreeterHTTPServer(srv, greeter)
     rv1.RegisterReviewHTTPServer(srv, review)
+    srv.Handle("/metrics", promhttp.Handler())
+    srv.HandleFunc("/healthz", h.Live)
+    srv.HandleFunc("/readyz", h.Ready)
     return srv
 }
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/metrics"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// secondsHistogramName is exported without a unit suffix, as
+// server_requests_seconds_bucket, _sum and _count.
+const secondsHistogramName = "server_requests_seconds"
+
+// Metrics holds the per-operation request metrics of both servers. They are
+// exported to the default Prometheus registry, which the HTTP server serves
+// at /metrics along with the data layer's counters.
+type Metrics struct {
+	requests metric.Int64Counter
+	seconds  metric.Float64Histogram
+}
+
+// NewMetrics creates the request counter and latency histogram.
+func NewMetrics() (*Metrics, error) {
+	exporter, err := prometheus.New(prometheus.WithoutUnits())
+	if err != nil {
+		return nil, err
+	}
+	provider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(exporter),
+		sdkmetric.WithView(metrics.DefaultSecondsHistogramView(secondsHistogramName)),
+	)
+	meter := provider.Meter("review-service")
+	m := &Metrics{}
+	if m.requests, err = metrics.DefaultRequestsCounter(meter, metrics.DefaultServerRequestsCounterName); err != nil {
+		return nil, err
+	}
+	if m.seconds, err = metrics.DefaultSecondsHistogram(meter, secondsHistogramName); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Middleware counts requests by kind, operation, code and reason, and
+// records their latency.
+func (m *Metrics) Middleware() middleware.Middleware {
+	return metrics.Server(metrics.WithRequests(m.requests), metrics.WithSeconds(m.seconds))
+}
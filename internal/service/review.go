@@ -20,7 +20,7 @@ func (s *ReviewService) Uc() *biz.ReviewUsecase { return s.uc }
 
 func (s *ReviewService) CreateReview(ctx context.Context, req *pb.CreateReviewRequest) (*pb.CreateReviewReply, error) {
 	id, err := s.uc.Create(ctx, &biz.Review{
-		UserID:  uint64(req.UserId),
+		UserID:  callerID(ctx, req.UserId),
 		Subject: req.Subject,
 		Content: req.Content,
 		Rating:  req.Rating,
@@ -37,7 +37,8 @@ func (s *ReviewService) UpdateReview(ctx context.Context, req *pb.UpdateReviewRe
 		Subject: req.Subject,
 		Content: req.Content,
 		Rating:  req.Rating,
-	})
+		Version: req.ExpectedVersion,
+	}, req.GetUpdateMask().GetPaths())
 	if err != nil {
 		return nil, err
 	}
@@ -51,23 +52,19 @@ func (s *ReviewService) DeleteReview(ctx context.Context, req *pb.DeleteReviewRe
 	return &pb.DeleteReviewReply{}, nil
 }
 
+func (s *ReviewService) RestoreReview(ctx context.Context, req *pb.RestoreReviewRequest) (*pb.RestoreReviewReply, error) {
+	if err := s.uc.Restore(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.RestoreReviewReply{}, nil
+}
+
 func (s *ReviewService) GetReview(ctx context.Context, req *pb.GetReviewRequest) (*pb.GetReviewReply, error) {
 	r, err := s.uc.Get(ctx, req.Id)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.GetReviewReply{Review: &pb.ReviewRecord{
-		Id:          r.ID,
-		UserId:      r.UserID,
-		Subject:     r.Subject,
-		Content:     r.Content,
-		Rating:      r.Rating,
-		CreatedAt:   0,
-		Status:      r.Status,
-		AuditReason: "",
-		AuditBy:     0,
-		AuditAt:     0,
-	}}, nil
+	return &pb.GetReviewReply{Review: toReviewRecord(r)}, nil
 }
 
 func (s *ReviewService) ListReview(ctx context.Context, req *pb.ListReviewRequest) (*pb.ListReviewReply, error) {
@@ -78,6 +75,8 @@ func (s *ReviewService) ListReview(ctx context.Context, req *pb.ListReviewReques
 		UserID:    req.UserId,
 		RatingMin: req.RatingMin,
 		RatingMax: req.RatingMax,
+		HasReply:  req.HasReply,
+		Statuses:  req.Statuses,
 		Sort:      req.Sort,
 		Order:     req.Order,
 	})
@@ -86,31 +85,27 @@ func (s *ReviewService) ListReview(ctx context.Context, req *pb.ListReviewReques
 	}
 	items := make([]*pb.ReviewRecord, 0, len(rs))
 	for _, r := range rs {
-		items = append(items, &pb.ReviewRecord{
-			Id:          r.ID,
-			UserId:      r.UserID,
-			Subject:     r.Subject,
-			Content:     r.Content,
-			Rating:      r.Rating,
-			CreatedAt:   0,
-			Status:      r.Status,
-			AuditReason: "",
-			AuditBy:     0,
-			AuditAt:     0,
-		})
+		items = append(items, toReviewRecord(r))
 	}
 	return &pb.ListReviewReply{Total: total, Reviews: items}, nil
 }
 
 func (s *ReviewService) AuditReview(ctx context.Context, req *pb.AuditReviewRequest) (*pb.AuditReviewReply, error) {
-	if err := s.uc.Audit(ctx, req.Id, req.Decision, req.Reason, req.OperatorId); err != nil {
+	if err := s.uc.Audit(ctx, req.Id, req.Decision, req.Reason, callerID(ctx, req.OperatorId), req.ExpectedVersion); err != nil {
 		return nil, err
 	}
 	return &pb.AuditReviewReply{}, nil
 }
 
+func (s *ReviewService) AppealReview(ctx context.Context, req *pb.AppealReviewRequest) (*pb.AppealReviewReply, error) {
+	if err := s.uc.Appeal(ctx, req.Id, callerID(ctx, req.UserId), req.Reason); err != nil {
+		return nil, err
+	}
+	return &pb.AppealReviewReply{}, nil
+}
+
 func (s *ReviewService) CreateReply(ctx context.Context, req *pb.CreateReplyRequest) (*pb.CreateReplyReply, error) {
-	if err := s.uc.AddReply(ctx, &biz.ReviewReply{ReviewID: req.Id, MerchantID: req.MerchantId, Content: req.Content}); err != nil {
+	if err := s.uc.AddReply(ctx, &biz.ReviewReply{ReviewID: req.Id, MerchantID: callerID(ctx, req.MerchantId), Content: req.Content}); err != nil {
 		return nil, err
 	}
 	return &pb.CreateReplyReply{}, nil
@@ -128,6 +123,27 @@ func (s *ReviewService) ListReplies(ctx context.Context, req *pb.ListRepliesRequ
 	return &pb.ListRepliesReply{Replies: items}, nil
 }
 
+func (s *ReviewService) ListAuditHistory(ctx context.Context, req *pb.ListAuditHistoryRequest) (*pb.ListAuditHistoryReply, error) {
+	list, err := s.uc.ListAuditHistory(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*pb.AuditRecord, 0, len(list))
+	for _, r := range list {
+		items = append(items, &pb.AuditRecord{
+			Id:         r.ID,
+			ReviewId:   r.ReviewID,
+			Action:     string(r.Action),
+			FromStatus: r.FromStatus,
+			ToStatus:   r.ToStatus,
+			OperatorId: r.OperatorID,
+			Reason:     r.Reason,
+			CreatedAt:  r.CreatedAt,
+		})
+	}
+	return &pb.ListAuditHistoryReply{Records: items}, nil
+}
+
 func (s *ReviewService) ListPendingReview(ctx context.Context, req *pb.ListPendingReviewRequest) (*pb.ListPendingReviewReply, error) {
 	rs, total, err := s.uc.ListPending(ctx, req.Page, req.PageSize)
 	if err != nil {
@@ -135,15 +151,34 @@ func (s *ReviewService) ListPendingReview(ctx context.Context, req *pb.ListPendi
 	}
 	items := make([]*pb.ReviewRecord, 0, len(rs))
 	for _, r := range rs {
-		items = append(items, &pb.ReviewRecord{
-			Id:        r.ID,
-			UserId:    r.UserID,
-			Subject:   r.Subject,
-			Content:   r.Content,
-			Rating:    r.Rating,
-			CreatedAt: 0,
-			Status:    r.Status,
-		})
+		items = append(items, toReviewRecord(r))
 	}
 	return &pb.ListPendingReviewReply{Total: total, Reviews: items}, nil
 }
+
+// callerID is the authenticated caller's id, which overrides the id in the
+// request body; the body is only trusted when the server runs without auth.
+func callerID(ctx context.Context, fromRequest uint64) uint64 {
+	if c := biz.CallerFromContext(ctx); c != nil {
+		return c.UserID
+	}
+	return fromRequest
+}
+
+func toReviewRecord(r *biz.Review) *pb.ReviewRecord {
+	return &pb.ReviewRecord{
+		Id:          r.ID,
+		UserId:      r.UserID,
+		Subject:     r.Subject,
+		Content:     r.Content,
+		Rating:      r.Rating,
+		CreatedAt:   r.CreatedAt,
+		Status:      r.Status,
+		AuditReason: r.AuditReason,
+		AuditBy:     r.AuditBy,
+		AuditAt:     r.AuditAt,
+		ReplyCount:  r.ReplyCount,
+		LastReplyAt: r.LastReplyAt,
+		Version:     r.Version,
+	}
+}
@@ -0,0 +1,87 @@
+// Package telemetry sets up OpenTelemetry for review-service and review-task
+// and carries trace context across Kafka.
+package telemetry
+
+import (
+	"context"
+
+	"review-service/internal/conf"
+
+	kafka "github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// Setup installs the global W3C trace-context propagator and, when
+// c.Endpoint is set, a tracer provider exporting to it over OTLP/gRPC. The
+// returned shutdown flushes pending spans.
+func Setup(ctx context.Context, c *conf.Trace, service, version, instance string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if c.GetEndpoint() == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(c.Endpoint)}
+	if c.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	sampler := sdktrace.AlwaysSample()
+	if r := c.SampleRatio; r > 0 && r < 1 {
+		sampler = sdktrace.TraceIDRatioBased(r)
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler)),
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName(service),
+			semconv.ServiceVersion(version),
+			semconv.ServiceInstanceID(instance),
+		)),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// HeaderCarrier adapts Kafka message headers to a propagation.TextMapCarrier.
+type HeaderCarrier struct {
+	Headers *[]kafka.Header
+}
+
+var _ propagation.TextMapCarrier = HeaderCarrier{}
+
+// Get returns the value of the first header named key.
+func (c HeaderCarrier) Get(key string) string {
+	for _, h := range *c.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+// Set replaces the headers named key with one holding value.
+func (c HeaderCarrier) Set(key, value string) {
+	hs := (*c.Headers)[:0:0]
+	for _, h := range *c.Headers {
+		if h.Key != key {
+			hs = append(hs, h)
+		}
+	}
+	*c.Headers = append(hs, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+// Keys lists the header names.
+func (c HeaderCarrier) Keys() []string {
+	keys := make([]string, 0, len(*c.Headers))
+	for _, h := range *c.Headers {
+		keys = append(keys, h.Key)
+	}
+	return keys
+}